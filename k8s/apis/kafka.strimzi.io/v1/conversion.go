@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1
+
+import (
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// KafkaTopicFromV1beta2 converts a v1beta2 KafkaTopic into its v1 representation.
+// The apiVersion is rewritten if it is set, all other metadata is copied as is.
+func KafkaTopicFromV1beta2(topic v1beta2.KafkaTopic) KafkaTopic {
+	in := topic.DeepCopy()
+	result := KafkaTopic{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: in.ObjectMeta,
+	}
+	if result.APIVersion != "" {
+		result.APIVersion = SchemeGroupVersion.String()
+	}
+	if in.Spec != nil {
+		result.Spec = &KafkaTopicSpec{
+			Config:     in.Spec.Config,
+			Partitions: in.Spec.Partitions,
+			Replicas:   in.Spec.Replicas,
+			TopicName:  in.Spec.TopicName,
+		}
+	}
+	if in.Status != nil {
+		result.Status = &KafkaTopicStatus{
+			TopicName: in.Status.TopicName,
+		}
+		if in.Status.ObservedGeneration != nil {
+			observedGeneration := int64(*in.Status.ObservedGeneration)
+			result.Status.ObservedGeneration = &observedGeneration
+		}
+		for _, condition := range in.Status.Conditions {
+			result.Status.Conditions = append(result.Status.Conditions, Condition{
+				LastTransitionTime: condition.LastTransitionTime,
+				Message:            condition.Message,
+				Reason:             condition.Reason,
+				Status:             condition.Status,
+				Type:               condition.Type,
+			})
+		}
+	}
+	return result
+}
+
+// KafkaTopicToV1beta2 converts a v1 KafkaTopic into its v1beta2 representation.
+// The apiVersion is rewritten if it is set, all other metadata is copied as is.
+// Status fields that only exist in v1 are dropped.
+func KafkaTopicToV1beta2(topic KafkaTopic) v1beta2.KafkaTopic {
+	in := topic.DeepCopy()
+	result := v1beta2.KafkaTopic{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: in.ObjectMeta,
+	}
+	if result.APIVersion != "" {
+		result.APIVersion = v1beta2.SchemeGroupVersion.String()
+	}
+	if in.Spec != nil {
+		result.Spec = &v1beta2.KafkaTopicSpec{
+			Config:     in.Spec.Config,
+			Partitions: in.Spec.Partitions,
+			Replicas:   in.Spec.Replicas,
+			TopicName:  in.Spec.TopicName,
+		}
+	}
+	if in.Status != nil {
+		result.Status = &v1beta2.KafkaTopicStatus{
+			TopicName: in.Status.TopicName,
+		}
+		if in.Status.ObservedGeneration != nil {
+			observedGeneration := int32(*in.Status.ObservedGeneration)
+			result.Status.ObservedGeneration = &observedGeneration
+		}
+		for _, condition := range in.Status.Conditions {
+			result.Status.Conditions = append(
+				result.Status.Conditions,
+				v1beta2.KafkaTopicStatusConditionsElem{
+					LastTransitionTime: condition.LastTransitionTime,
+					Message:            condition.Message,
+					Reason:             condition.Reason,
+					Status:             condition.Status,
+					Type:               condition.Type,
+				},
+			)
+		}
+	}
+	return result
+}
+
+// KafkaTopicsFromV1beta2 converts a list of v1beta2 KafkaTopics into v1.
+func KafkaTopicsFromV1beta2(topics v1beta2.KafkaTopics) KafkaTopics {
+	result := make(KafkaTopics, 0, len(topics))
+	for _, topic := range topics {
+		result = append(result, KafkaTopicFromV1beta2(topic))
+	}
+	return result
+}
+
+// KafkaTopicsToV1beta2 converts a list of v1 KafkaTopics into v1beta2.
+func KafkaTopicsToV1beta2(topics KafkaTopics) v1beta2.KafkaTopics {
+	result := make(v1beta2.KafkaTopics, 0, len(topics))
+	for _, topic := range topics {
+		result = append(result, KafkaTopicToV1beta2(topic))
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1_test
+
+import (
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("Conversion", func() {
+	var topic v1beta2.KafkaTopic
+	BeforeEach(func() {
+		topic = v1beta2.KafkaTopic{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "kafka.strimzi.io/v1beta2",
+				Kind:       "KafkaTopic",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "my-topic",
+				Namespace:       "kafka",
+				ResourceVersion: "42",
+				Labels:          map[string]string{"strimzi.io/cluster": "my-cluster"},
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+				Replicas:   collection.Ptr(int32(2)),
+				TopicName:  collection.Ptr("my.topic"),
+				Config:     map[string]string{"retention.ms": "1000"},
+			},
+			Status: &v1beta2.KafkaTopicStatus{
+				ObservedGeneration: collection.Ptr(int32(7)),
+				TopicName:          collection.Ptr("my.topic"),
+				Conditions: []v1beta2.KafkaTopicStatusConditionsElem{
+					{Type: collection.Ptr("Ready"), Status: collection.Ptr("True")},
+				},
+			},
+		}
+	})
+	Context("KafkaTopicFromV1beta2", func() {
+		var result kafkav1.KafkaTopic
+		BeforeEach(func() {
+			result = kafkav1.KafkaTopicFromV1beta2(topic)
+		})
+		It("rewrites the apiVersion", func() {
+			Expect(result.APIVersion).To(Equal("kafka.strimzi.io/v1"))
+			Expect(result.Kind).To(Equal("KafkaTopic"))
+		})
+		It("copies metadata", func() {
+			Expect(result.Name).To(Equal("my-topic"))
+			Expect(result.ResourceVersion).To(Equal("42"))
+			Expect(result.Labels).To(HaveKeyWithValue("strimzi.io/cluster", "my-cluster"))
+		})
+		It("copies spec", func() {
+			Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+			Expect(*result.Spec.Replicas).To(Equal(int32(2)))
+			Expect(result.TopicName()).To(Equal("my.topic"))
+			Expect(result.Spec.Config).To(HaveKeyWithValue("retention.ms", "1000"))
+		})
+		It("copies status", func() {
+			Expect(*result.Status.ObservedGeneration).To(Equal(int64(7)))
+			Expect(result.Status.Conditions).To(HaveLen(1))
+			Expect(*result.Status.Conditions[0].Type).To(Equal("Ready"))
+		})
+		It("does not share memory with the input", func() {
+			result.Spec.Config["retention.ms"] = "2000"
+			result.Labels["foo"] = "bar"
+			Expect(topic.Spec.Config).To(HaveKeyWithValue("retention.ms", "1000"))
+			Expect(topic.Labels).NotTo(HaveKey("foo"))
+		})
+		It("round trips", func() {
+			Expect(kafkav1.KafkaTopicToV1beta2(result)).To(Equal(topic))
+		})
+	})
+	It("keeps an empty apiVersion empty", func() {
+		topic.TypeMeta = metav1.TypeMeta{}
+		Expect(kafkav1.KafkaTopicFromV1beta2(topic).APIVersion).To(BeEmpty())
+	})
+	It("converts topics without spec and status", func() {
+		result := kafkav1.KafkaTopicFromV1beta2(v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-topic"},
+		})
+		Expect(result.Spec).To(BeNil())
+		Expect(result.Status).To(BeNil())
+		Expect(kafkav1.KafkaTopicToV1beta2(result).Name).To(Equal("my-topic"))
+	})
+	It("converts lists", func() {
+		result := kafkav1.KafkaTopicsFromV1beta2(v1beta2.KafkaTopics{topic, topic})
+		Expect(result).To(HaveLen(2))
+		Expect(kafkav1.KafkaTopicsToV1beta2(result)).To(Equal(v1beta2.KafkaTopics{topic, topic}))
+	})
+})
@@ -0,0 +1,10 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +k8s:deepcopy-gen=package,register
+
+// Package v1 is the v1 version of the API. It models KafkaTopic and the metadata of
+// Kafka, the other resources are only available in v1beta2.
+// +groupName=kafka.strimzi.io
+package v1
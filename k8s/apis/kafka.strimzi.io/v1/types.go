@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type KafkaTopics []KafkaTopic
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaTopic struct {
+	// The specification of the topic.
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec *KafkaTopicSpec `json:"spec,omitempty"`
+
+	// The status of the topic.
+	Status *KafkaTopicStatus `json:"status,omitempty"`
+}
+
+func (t KafkaTopic) TopicName() string {
+	if t.Spec != nil && t.Spec.TopicName != nil && *t.Spec.TopicName != "" {
+		return *t.Spec.TopicName
+	}
+	return t.Name
+}
+
+// Equal reports whether both topics result in the same Kafka topic, see
+// v1beta2.KafkaTopic.Diff for the details.
+func (t KafkaTopic) Equal(kafkaTopic KafkaTopic) bool {
+	return KafkaTopicToV1beta2(t).Equal(KafkaTopicToV1beta2(kafkaTopic))
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of Kafka objects.
+	Items []KafkaTopic `json:"items,omitempty"`
+}
+
+type KafkaTopicSpecs []KafkaTopicSpec
+
+type KafkaTopicSpec struct {
+	// The topic configuration.
+	Config map[string]string `json:"config,omitempty"`
+
+	// The number of partitions the topic should have. This cannot be decreased after
+	// topic creation. It can be increased after topic creation, but it is important
+	// to understand the consequences that has, especially for topics with semantic
+	// partitioning. When absent this will default to the broker configuration for
+	// `num.partitions`.
+	Partitions *int32 `json:"partitions,omitempty"`
+
+	// The number of replicas the topic should have. When absent this will default to
+	// the broker configuration for `default.replication.factor`.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The name of the topic. When absent this will default to the metadata.name of
+	// the topic. It is recommended to not set this unless the topic name is not a
+	// valid Kubernetes resource name.
+	TopicName *string `json:"topicName,omitempty"`
+}
+
+// The topic configuration.
+//type KafkaTopicSpecConfig map[string]interface{}
+
+// The status of the topic.
+type KafkaTopicStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Topic name.
+	TopicName *string `json:"topicName,omitempty"`
+
+	// Replication factor change status.
+	ReplicasChange *ReplicasChangeStatus `json:"replicasChange,omitempty"`
+}
+
+type ReplicasChangeStatus struct {
+	// Current state of the replicas change operation. This can be `pending`,
+	// when the change has been requested, or `ongoing`, when the change has been
+	// successfully submitted to Cruise Control.
+	State *string `json:"state,omitempty"`
+
+	// The target replicas value requested by the user. This may be different
+	// from .spec.replicas when a change is ongoing.
+	TargetReplicas *int32 `json:"targetReplicas,omitempty"`
+
+	// Message for the user related to the replicas change request. This may
+	// contain transient error messages that would disappear on periodic
+	// reconciliations.
+	Message *string `json:"message,omitempty"`
+
+	// The session identifier for replicas change requests pertaining to this
+	// KafkaTopic resource. This is used by the Topic Operator to track the
+	// status of ongoing replicas change operations.
+	SessionID *string `json:"sessionId,omitempty"`
+}
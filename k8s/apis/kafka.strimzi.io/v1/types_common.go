@@ -0,0 +1,26 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1
+
+// Condition describes the state of a Strimzi custom resource at a certain point.
+type Condition struct {
+	// Last time the condition of a type changed from one status to another. The
+	// required format is 'yyyy-MM-ddTHH:mm:ssZ', in the UTC time zone.
+	LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+
+	// Human-readable message indicating details about the condition's last
+	// transition.
+	Message *string `json:"message,omitempty"`
+
+	// The reason for the condition's last transition (a single word in CamelCase).
+	Reason *string `json:"reason,omitempty"`
+
+	// The status of the condition, either True, False or Unknown.
+	Status *string `json:"status,omitempty"`
+
+	// The unique identifier of a condition, used to distinguish between other
+	// conditions in the resource.
+	Type *string `json:"type,omitempty"`
+}
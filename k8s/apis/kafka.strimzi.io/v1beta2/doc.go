@@ -5,5 +5,5 @@
 // +k8s:deepcopy-gen=package,register
 
 // Package v1beta2 is the v1beta2 version of the API.
-// +groupName=kafka
+// +groupName=kafka.strimzi.io
 package v1beta2
@@ -37,8 +37,24 @@ func init() {
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Kafka{},
+		&KafkaList{},
+		&KafkaBridge{},
+		&KafkaBridgeList{},
+		&KafkaConnect{},
+		&KafkaConnectList{},
+		&KafkaConnector{},
+		&KafkaConnectorList{},
+		&KafkaMirrorMaker2{},
+		&KafkaMirrorMaker2List{},
+		&KafkaNodePool{},
+		&KafkaNodePoolList{},
+		&KafkaRebalance{},
+		&KafkaRebalanceList{},
 		&KafkaTopic{},
 		&KafkaTopicList{},
+		&KafkaUser{},
+		&KafkaUserList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
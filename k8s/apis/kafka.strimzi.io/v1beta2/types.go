@@ -5,15 +5,16 @@
 package v1beta2
 
 import (
-	"reflect"
+	"context"
+	"time"
 
+	"github.com/bborbe/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type KafkaTopics []KafkaTopic
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type KafkaTopic struct {
 	// The specification of the topic.
@@ -33,26 +34,39 @@ func (t KafkaTopic) TopicName() string {
 	return t.Name
 }
 
+// Equal reports whether both topics result in the same Kafka topic. The topic names,
+// partitions, replicas and config are compared by value, see Diff for the details.
 func (t KafkaTopic) Equal(kafkaTopic KafkaTopic) bool {
+	return t.Diff(kafkaTopic).IsEmpty()
+}
+
+// Diff returns the changes from t to the given topic.
+//
+// Unset partitions and replicas are defaulted by the broker. Because that default is
+// unknown here, an unset value differs from every explicit value. A nil spec equals an
+// empty spec and a nil config equals an empty config.
+//
+// Config values are compared the way Kafka parses them: surrounding whitespace is
+// ignored, numbers are compared numerically ("604800000" equals "+604800000"), booleans
+// case-insensitively and the cleanup.policy regardless of order. Kafka does not accept
+// unit suffixes like "7d" for topic configs, so these are compared as plain strings.
+func (t KafkaTopic) Diff(kafkaTopic KafkaTopic) KafkaTopicDiff {
+	var result KafkaTopicDiff
 	if t.TopicName() != kafkaTopic.TopicName() {
-		return false
-	}
-	if t.Spec == nil && kafkaTopic.Spec == nil {
-		return true
-	}
-	if t.Spec == nil || kafkaTopic.Spec == nil {
-		return false
-	}
-	if t.Spec.Partitions != kafkaTopic.Spec.Partitions {
-		return false
+		result.TopicName = &StringChange{Old: t.TopicName(), New: kafkaTopic.TopicName()}
 	}
-	if t.Spec.Replicas != kafkaTopic.Spec.Replicas {
-		return false
+	oldSpec := t.Spec
+	if oldSpec == nil {
+		oldSpec = &KafkaTopicSpec{}
 	}
-	if !reflect.DeepEqual(t.Spec.Config, kafkaTopic.Spec.Config) {
-		return false
+	newSpec := kafkaTopic.Spec
+	if newSpec == nil {
+		newSpec = &KafkaTopicSpec{}
 	}
-	return true
+	result.Partitions = newInt32Change(oldSpec.Partitions, newSpec.Partitions)
+	result.Replicas = newInt32Change(oldSpec.Replicas, newSpec.Replicas)
+	result.Config = newConfigChanges(oldSpec.Config, newSpec.Config)
+	return result
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -121,3 +135,143 @@ type KafkaTopicStatusConditionsElem struct {
 	// conditions in the resource.
 	Type *string `json:"type,omitempty"`
 }
+
+const (
+	// ClusterLabel names the Kafka cluster a KafkaTopic belongs to. The topic operator
+	// only reconciles topics labeled with its cluster.
+	ClusterLabel = "strimzi.io/cluster"
+	// ManagedAnnotation set to "false" detaches a KafkaTopic from the topic operator.
+	// Changes and the deletion of the resource are no longer applied to Kafka.
+	ManagedAnnotation = "strimzi.io/managed"
+	// TopicOperatorFinalizer is added by the unidirectional topic operator to delay the
+	// deletion of a KafkaTopic until the Kafka topic is deleted.
+	TopicOperatorFinalizer = "strimzi.io/topic-operator"
+)
+
+// Condition types reported by the topic operator for a KafkaTopic.
+const (
+	KafkaTopicConditionReady     = "Ready"
+	KafkaTopicConditionUnmanaged = "Unmanaged"
+	KafkaTopicConditionWarning   = "Warning"
+)
+
+// Condition statuses reported by the operator.
+const (
+	ConditionStatusTrue    = "True"
+	ConditionStatusFalse   = "False"
+	ConditionStatusUnknown = "Unknown"
+)
+
+// GetCondition returns the condition with the given type or nil if the status is nil
+// or has no such condition.
+func (s *KafkaTopicStatus) GetCondition(conditionType string) *KafkaTopicStatusConditionsElem {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Conditions {
+		if s.Conditions[i].GetType() == conditionType {
+			return &s.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsReady returns true if the Ready condition is True. It does not check whether the
+// operator reconciled the latest generation, see IsReconciled.
+func (s *KafkaTopicStatus) IsReady() bool {
+	return s.GetCondition(KafkaTopicConditionReady).IsTrue()
+}
+
+// IsReconciled returns true if the operator has observed the given generation,
+// usually the metadata.generation of the topic.
+func (s *KafkaTopicStatus) IsReconciled(generation int64) bool {
+	if s == nil || s.ObservedGeneration == nil {
+		return false
+	}
+	return int64(*s.ObservedGeneration) >= generation
+}
+
+// UnmanagedWarning returns a warning and true if the operator reports the topic as
+// unmanaged, i.e. changes to the KafkaTopic are not applied to Kafka.
+func (s *KafkaTopicStatus) UnmanagedWarning() (string, bool) {
+	condition := s.GetCondition(KafkaTopicConditionUnmanaged)
+	if !condition.IsTrue() {
+		return "", false
+	}
+	if message := condition.String(); message != "" {
+		return message, true
+	}
+	return "topic is not managed by the topic operator", true
+}
+
+// GetType returns the type of the condition or an empty string.
+func (c *KafkaTopicStatusConditionsElem) GetType() string {
+	if c == nil {
+		return ""
+	}
+	return valueOrDefault(c.Type, "")
+}
+
+// GetStatus returns the status of the condition or an empty string.
+func (c *KafkaTopicStatusConditionsElem) GetStatus() string {
+	if c == nil {
+		return ""
+	}
+	return valueOrDefault(c.Status, "")
+}
+
+// GetReason returns the reason of the condition or an empty string.
+func (c *KafkaTopicStatusConditionsElem) GetReason() string {
+	if c == nil {
+		return ""
+	}
+	return valueOrDefault(c.Reason, "")
+}
+
+// GetMessage returns the message of the condition or an empty string.
+func (c *KafkaTopicStatusConditionsElem) GetMessage() string {
+	if c == nil {
+		return ""
+	}
+	return valueOrDefault(c.Message, "")
+}
+
+// IsTrue returns true if the status of the condition is True.
+func (c *KafkaTopicStatusConditionsElem) IsTrue() bool {
+	return c.GetStatus() == ConditionStatusTrue
+}
+
+// IsFalse returns true if the status of the condition is False.
+func (c *KafkaTopicStatusConditionsElem) IsFalse() bool {
+	return c.GetStatus() == ConditionStatusFalse
+}
+
+// ParseLastTransitionTime parses the last transition time of the condition. A zero
+// time is returned if it is not set.
+func (c *KafkaTopicStatusConditionsElem) ParseLastTransitionTime(
+	ctx context.Context,
+) (time.Time, error) {
+	if c == nil || c.LastTransitionTime == nil || *c.LastTransitionTime == "" {
+		return time.Time{}, nil
+	}
+	result, err := time.Parse(time.RFC3339, *c.LastTransitionTime)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(
+			ctx,
+			err,
+			"parse lastTransitionTime '%s' failed",
+			*c.LastTransitionTime,
+		)
+	}
+	return result, nil
+}
+
+// String returns reason and message of the condition as "Reason: Message".
+func (c *KafkaTopicStatusConditionsElem) String() string {
+	reason := c.GetReason()
+	message := c.GetMessage()
+	if reason == "" {
+		return message
+	}
+	return reason + ": " + message
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Condition describes the state of a Strimzi custom resource at a certain point.
+type Condition struct {
+	// Last time the condition of a type changed from one status to another. The
+	// required format is 'yyyy-MM-ddTHH:mm:ssZ', in the UTC time zone.
+	LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+
+	// Human-readable message indicating details about the condition's last
+	// transition.
+	Message *string `json:"message,omitempty"`
+
+	// The reason for the condition's last transition (a single word in CamelCase).
+	Reason *string `json:"reason,omitempty"`
+
+	// The status of the condition, either True, False or Unknown.
+	Status *string `json:"status,omitempty"`
+
+	// The unique identifier of a condition, used to distinguish between other
+	// conditions in the resource.
+	Type *string `json:"type,omitempty"`
+}
+
+// Probe configures the liveness, readiness or startup probe of a container.
+type Probe struct {
+	// Minimum consecutive failures for the probe to be considered failed after
+	// having succeeded. Defaults to 3. Minimum value is 1.
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// The initial delay before first the health is first checked. Default to 15
+	// seconds. Minimum value is 0.
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+
+	// How often (in seconds) to perform the probe. Default to 10 seconds. Minimum
+	// value is 1.
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// Minimum consecutive successes for the probe to be considered successful after
+	// having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+	SuccessThreshold *int32 `json:"successThreshold,omitempty"`
+
+	// The timeout for each attempted health check. Default to 5 seconds. Minimum
+	// value is 1.
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// JvmOptions configures the JVM of a Strimzi managed container.
+type JvmOptions struct {
+	// A map of -XX options to the JVM.
+	XX map[string]string `json:"-XX,omitempty"`
+
+	// -Xms option to to the JVM.
+	Xms *string `json:"-Xms,omitempty"`
+
+	// -Xmx option to to the JVM.
+	Xmx *string `json:"-Xmx,omitempty"`
+
+	// Specifies whether the Garbage Collection logging is enabled. The default is
+	// false.
+	GcLoggingEnabled *bool `json:"gcLoggingEnabled,omitempty"`
+
+	// A map of additional system properties which will be passed using the `-D`
+	// option to the JVM.
+	JavaSystemProperties []SystemProperty `json:"javaSystemProperties,omitempty"`
+}
+
+// SystemProperty is a single Java system property.
+type SystemProperty struct {
+	// The system property name.
+	Name *string `json:"name,omitempty"`
+
+	// The system property value.
+	Value *string `json:"value,omitempty"`
+}
+
+// Logging configures the loggers of a Strimzi managed component.
+type Logging struct {
+	// Logging type, must be either 'inline' or 'external'.
+	Type *string `json:"type,omitempty"`
+
+	// A Map from logger name to logger level. Only used with type 'inline'.
+	Loggers map[string]string `json:"loggers,omitempty"`
+
+	// `ConfigMap` entry where the logging configuration is stored. Only used with
+	// type 'external'.
+	ValueFrom *ExternalConfigurationReference `json:"valueFrom,omitempty"`
+}
+
+// MetricsConfig configures the Prometheus JMX exporter of a Strimzi managed component.
+type MetricsConfig struct {
+	// Metrics type. Only 'jmxPrometheusExporter' supported currently.
+	Type *string `json:"type,omitempty"`
+
+	// ConfigMap entry where the Prometheus JMX Exporter configuration is stored.
+	ValueFrom *ExternalConfigurationReference `json:"valueFrom,omitempty"`
+}
+
+// ExternalConfigurationReference references a ConfigMap key holding configuration.
+type ExternalConfigurationReference struct {
+	// Reference to the key in the ConfigMap containing the configuration.
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// Storage configures the storage of a Kafka or ZooKeeper node.
+type Storage struct {
+	// Storage type, must be either 'ephemeral', 'persistent-claim', or 'jbod'.
+	Type *string `json:"type,omitempty"`
+
+	// Storage identification number. It is mandatory only for storage volumes
+	// defined in a storage of type 'jbod'.
+	ID *int32 `json:"id,omitempty"`
+
+	// When type=persistent-claim, defines the size of the persistent volume claim,
+	// such as 100Gi. Mandatory when type=persistent-claim.
+	Size *string `json:"size,omitempty"`
+
+	// When type=ephemeral, defines the total amount of local storage required for
+	// this EmptyDir volume (for example 1Gi).
+	SizeLimit *string `json:"sizeLimit,omitempty"`
+
+	// The storage class to use for dynamic volume allocation.
+	Class *string `json:"class,omitempty"`
+
+	// Specifies if the persistent volume claim has to be deleted when the cluster
+	// is un-deployed.
+	DeleteClaim *bool `json:"deleteClaim,omitempty"`
+
+	// Specifies whether this volume should be used for storing KRaft metadata.
+	// This property is optional. When set, the only currently supported value is
+	// `shared`. At most one volume can have this property set.
+	KraftMetadata *string `json:"kraftMetadata,omitempty"`
+
+	// List of volumes as Storage objects representing the JBOD disks array.
+	Volumes []Storage `json:"volumes,omitempty"`
+}
+
+// CertificateAuthority configures how TLS certificates are used within the cluster.
+type CertificateAuthority struct {
+	// If true then Certificate Authority certificates will be generated
+	// automatically. Otherwise the user will need to provide a Secret with the CA
+	// certificate. Default is true.
+	GenerateCertificateAuthority *bool `json:"generateCertificateAuthority,omitempty"`
+
+	// If true, the Cluster Operator will generate the secret name for the CA
+	// certificate. Default is true.
+	GenerateSecretOwnerReference *bool `json:"generateSecretOwnerReference,omitempty"`
+
+	// The number of days generated certificates should be valid for. The default
+	// is 365.
+	ValidityDays *int32 `json:"validityDays,omitempty"`
+
+	// The number of days in the certificate renewal period. This is the number of
+	// days before the a certificate expires during which renewal actions may be
+	// performed. Default is 30.
+	RenewalDays *int32 `json:"renewalDays,omitempty"`
+
+	// How should CA certificate expiration be handled when
+	// `generateCertificateAuthority=true`. The default is for a new CA certificate
+	// to be generated reusing the existing private key.
+	CertificateExpirationPolicy *string `json:"certificateExpirationPolicy,omitempty"`
+}
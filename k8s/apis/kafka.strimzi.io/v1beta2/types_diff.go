@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KafkaTopicDiff lists the differences of two KafkaTopics, see KafkaTopic.Diff.
+type KafkaTopicDiff struct {
+	TopicName  *StringChange `json:"topicName,omitempty"`
+	Partitions *Int32Change  `json:"partitions,omitempty"`
+	Replicas   *Int32Change  `json:"replicas,omitempty"`
+	Config     []KeyChange   `json:"config,omitempty"`
+}
+
+// IsEmpty returns true if both topics are equal.
+func (k KafkaTopicDiff) IsEmpty() bool {
+	return k.TopicName == nil && k.Partitions == nil && k.Replicas == nil && len(k.Config) == 0
+}
+
+// KeyChangeType describes how the value of a map key changed.
+type KeyChangeType string
+
+// Types of a KeyChange.
+const (
+	KeyChangeTypeAdded   KeyChangeType = "added"
+	KeyChangeTypeRemoved KeyChangeType = "removed"
+	KeyChangeTypeChanged KeyChangeType = "changed"
+)
+
+// String returns the type as string.
+func (k KeyChangeType) String() string {
+	return string(k)
+}
+
+// KeyChange is the change of a single key of a map like the topic config.
+type KeyChange struct {
+	Key  string        `json:"key"`
+	Type KeyChangeType `json:"type"`
+	Old  string        `json:"old,omitempty"`
+	New  string        `json:"new,omitempty"`
+}
+
+// String returns the change as "+ key: new", "- key: old" or "~ key: old -> new".
+func (k KeyChange) String() string {
+	switch k.Type {
+	case KeyChangeTypeAdded:
+		return fmt.Sprintf("+ %s: %s", k.Key, k.New)
+	case KeyChangeTypeRemoved:
+		return fmt.Sprintf("- %s: %s", k.Key, k.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", k.Key, k.Old, k.New)
+	}
+}
+
+// Int32Change is the change of an optional number like the partitions of a topic.
+// Nil means the value is not set and defaulted by the broker.
+type Int32Change struct {
+	Old *int32 `json:"old,omitempty"`
+	New *int32 `json:"new,omitempty"`
+}
+
+// String returns the change as "old -> new".
+func (i Int32Change) String() string {
+	return fmt.Sprintf("%s -> %s", formatInt32(i.Old), formatInt32(i.New))
+}
+
+// StringChange is the change of a string like the topic name.
+type StringChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// String returns the change as "old -> new".
+func (s StringChange) String() string {
+	return fmt.Sprintf("%s -> %s", s.Old, s.New)
+}
+
+func newInt32Change(oldValue *int32, newValue *int32) *Int32Change {
+	if oldValue == nil && newValue == nil {
+		return nil
+	}
+	if oldValue != nil && newValue != nil && *oldValue == *newValue {
+		return nil
+	}
+	return &Int32Change{Old: oldValue, New: newValue}
+}
+
+// newConfigChanges returns the changes of the config sorted by key. Values that Kafka
+// parses to the same value are not reported.
+func newConfigChanges(oldConfig map[string]string, newConfig map[string]string) []KeyChange {
+	var result []KeyChange
+	for key, value := range newConfig {
+		oldValue, ok := oldConfig[key]
+		switch {
+		case !ok:
+			result = append(result, KeyChange{Key: key, Type: KeyChangeTypeAdded, New: value})
+		case NormalizeConfigValue(key, oldValue) != NormalizeConfigValue(key, value):
+			result = append(result, KeyChange{
+				Key:  key,
+				Type: KeyChangeTypeChanged,
+				Old:  oldValue,
+				New:  value,
+			})
+		}
+	}
+	for key, value := range oldConfig {
+		if _, ok := newConfig[key]; !ok {
+			result = append(result, KeyChange{Key: key, Type: KeyChangeTypeRemoved, Old: value})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// NormalizeConfigValue returns the canonical form of a topic config value, so values
+// Kafka parses to the same value are equal.
+func NormalizeConfigValue(key string, value string) string {
+	value = strings.TrimSpace(value)
+	if key == "cleanup.policy" {
+		return normalizeSet(value)
+	}
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return strconv.FormatInt(number, 10)
+	}
+	if strings.EqualFold(value, "true") || strings.EqualFold(value, "false") {
+		return strings.ToLower(value)
+	}
+	if key == "min.cleanable.dirty.ratio" {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return strconv.FormatFloat(number, 'g', -1, 64)
+		}
+	}
+	return value
+}
+
+// normalizeSet sorts the comma separated values and removes duplicates.
+func normalizeSet(value string) string {
+	var values []string
+	seen := map[string]bool{}
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func formatInt32(value *int32) string {
+	if value == nil {
+		return "default"
+	}
+	return strconv.FormatInt(int64(*value), 10)
+}
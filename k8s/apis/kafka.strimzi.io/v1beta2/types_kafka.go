@@ -0,0 +1,442 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// KRaftAnnotation enables KRaft mode on a Kafka resource when set to "enabled".
+	KRaftAnnotation = "strimzi.io/kraft"
+	// NodePoolsAnnotation enables KafkaNodePool support on a Kafka resource when set to "enabled".
+	NodePoolsAnnotation = "strimzi.io/node-pools"
+)
+
+type Kafkas []Kafka
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type Kafka struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka and ZooKeeper clusters, and Topic Operator.
+	Spec *KafkaSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka and ZooKeeper clusters, and Topic Operator.
+	Status *KafkaStatus `json:"status,omitempty"`
+}
+
+// IsKRaft returns true if the cluster runs without ZooKeeper.
+func (k Kafka) IsKRaft() bool {
+	return k.Annotations[KRaftAnnotation] == "enabled"
+}
+
+// BootstrapServers returns the bootstrap servers the operator reported for
+// the listener with the given name, or an empty string if unknown.
+func (k Kafka) BootstrapServers(listenerName string) string {
+	if k.Status == nil {
+		return ""
+	}
+	for _, listener := range k.Status.Listeners {
+		if listener.Name != nil && *listener.Name == listenerName &&
+			listener.BootstrapServers != nil {
+			return *listener.BootstrapServers
+		}
+	}
+	return ""
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of Kafka objects.
+	Items []Kafka `json:"items,omitempty"`
+}
+
+type KafkaSpec struct {
+	// Configuration of the Kafka cluster.
+	Kafka *KafkaClusterSpec `json:"kafka,omitempty"`
+
+	// Configuration of the ZooKeeper cluster. This section is required when
+	// running a ZooKeeper-based Apache Kafka cluster.
+	Zookeeper *ZookeeperClusterSpec `json:"zookeeper,omitempty"`
+
+	// Configuration of the Entity Operator.
+	EntityOperator *EntityOperatorSpec `json:"entityOperator,omitempty"`
+
+	// Configuration for Cruise Control deployment. Deploys a Cruise Control
+	// instance when specified.
+	CruiseControl *CruiseControlSpec `json:"cruiseControl,omitempty"`
+
+	// Configuration of the cluster certificate authority.
+	ClusterCa *CertificateAuthority `json:"clusterCa,omitempty"`
+
+	// Configuration of the clients certificate authority.
+	ClientsCa *CertificateAuthority `json:"clientsCa,omitempty"`
+
+	// Configuration of the Kafka Exporter. Kafka Exporter can provide additional
+	// metrics, for example lag of consumer group at topic/partition.
+	KafkaExporter *runtime.RawExtension `json:"kafkaExporter,omitempty"`
+
+	// A list of time windows for maintenance tasks (that is, certificates
+	// renewal). Each time window is defined by a cron expression.
+	MaintenanceTimeWindows []string `json:"maintenanceTimeWindows,omitempty"`
+}
+
+type KafkaClusterSpec struct {
+	// The Kafka broker version. Defaults to the latest version.
+	Version *string `json:"version,omitempty"`
+
+	// The KRaft metadata version used by the Kafka cluster. This property is
+	// ignored when running in ZooKeeper mode.
+	MetadataVersion *string `json:"metadataVersion,omitempty"`
+
+	// The number of pods in the cluster. This property is required when node
+	// pools are not used.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The container image used for Kafka pods.
+	Image *string `json:"image,omitempty"`
+
+	// Configures listeners to provide access to Kafka brokers.
+	Listeners []GenericKafkaListener `json:"listeners,omitempty"`
+
+	// Kafka broker config properties.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// Storage configuration (disk). Cannot be updated. This property is required
+	// when node pools are not used.
+	Storage *Storage `json:"storage,omitempty"`
+
+	// Authorization configuration for Kafka brokers.
+	Authorization *runtime.RawExtension `json:"authorization,omitempty"`
+
+	// Configuration of the `broker.rack` broker config.
+	Rack *Rack `json:"rack,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Metrics configuration.
+	MetricsConfig *MetricsConfig `json:"metricsConfig,omitempty"`
+
+	// Logging configuration for Kafka.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// Template for Kafka cluster resources. The template allows users to specify
+	// how the Kubernetes resources are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	// Configure the tiered storage feature for Kafka brokers.
+	TieredStorage *runtime.RawExtension `json:"tieredStorage,omitempty"`
+
+	// Quotas plugin configuration for Kafka brokers allows setting quotas for
+	// disk usage, produce/fetch rates, and more.
+	Quotas *runtime.RawExtension `json:"quotas,omitempty"`
+}
+
+// GenericKafkaListener configures a listener of Kafka brokers.
+type GenericKafkaListener struct {
+	// Name of the listener. The name will be used to identify the listener and
+	// the related Kubernetes objects. The name has to be unique within given a
+	// Kafka cluster. The name can consist of lowercase characters and numbers and
+	// be up to 11 characters long.
+	Name *string `json:"name,omitempty"`
+
+	// Port number used by the listener inside Kafka. The port number has to be
+	// unique within a given Kafka cluster. Allowed port numbers are 9092 and
+	// higher with the exception of ports 9404 and 9999, which are already used
+	// for Prometheus and JMX. Depending on the listener type, the port number
+	// might not be the same as the port number that connects Kafka clients.
+	Port *int32 `json:"port,omitempty"`
+
+	// Type of the listener. The supported types are `internal`, `route`,
+	// `loadbalancer`, `nodeport`, `ingress` and `cluster-ip`.
+	Type *string `json:"type,omitempty"`
+
+	// Enables TLS encryption on the listener. This is a required property.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Authentication configuration for this listener.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+
+	// Additional listener configuration.
+	Configuration *runtime.RawExtension `json:"configuration,omitempty"`
+
+	// List of peers which should be able to connect to this listener. Peers in
+	// this list are combined using a logical OR operation. If this field is empty
+	// or missing, all connections will be allowed for this listener.
+	NetworkPolicyPeers *runtime.RawExtension `json:"networkPolicyPeers,omitempty"`
+}
+
+// Rack configures the rack awareness of Kafka brokers.
+type Rack struct {
+	// A key that matches labels assigned to the Kubernetes cluster nodes. The
+	// value of the label is used to set a broker's `broker.rack` config, and the
+	// `client.rack` config for Kafka Connect or MirrorMaker 2.
+	TopologyKey *string `json:"topologyKey,omitempty"`
+}
+
+type ZookeeperClusterSpec struct {
+	// The number of pods in the cluster.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The container image used for ZooKeeper pods.
+	Image *string `json:"image,omitempty"`
+
+	// Storage configuration (disk). Cannot be updated.
+	Storage *Storage `json:"storage,omitempty"`
+
+	// The ZooKeeper broker config.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Metrics configuration.
+	MetricsConfig *MetricsConfig `json:"metricsConfig,omitempty"`
+
+	// Logging configuration for ZooKeeper.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// Template for ZooKeeper cluster resources. The template allows users to
+	// specify how the Kubernetes resources are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+type EntityOperatorSpec struct {
+	// Configuration of the Topic Operator.
+	TopicOperator *EntityTopicOperatorSpec `json:"topicOperator,omitempty"`
+
+	// Configuration of the User Operator.
+	UserOperator *EntityUserOperatorSpec `json:"userOperator,omitempty"`
+
+	// TLS sidecar configuration. The TLS sidecar is not used anymore and this
+	// option will be ignored.
+	TLSSidecar *runtime.RawExtension `json:"tlsSidecar,omitempty"`
+
+	// Template for Entity Operator resources. The template allows users to
+	// specify how a `Deployment` and `Pod` is generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+type EntityTopicOperatorSpec struct {
+	// The namespace the Topic Operator should watch.
+	WatchedNamespace *string `json:"watchedNamespace,omitempty"`
+
+	// The image to use for the Topic Operator.
+	Image *string `json:"image,omitempty"`
+
+	// Interval between periodic reconciliations in milliseconds.
+	ReconciliationIntervalMs *int64 `json:"reconciliationIntervalMs,omitempty"`
+
+	// Timeout for the ZooKeeper session.
+	ZookeeperSessionTimeoutSeconds *int32 `json:"zookeeperSessionTimeoutSeconds,omitempty"`
+
+	// Pod startup checking.
+	StartupProbe *Probe `json:"startupProbe,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// The number of attempts at getting topic metadata.
+	TopicMetadataMaxAttempts *int32 `json:"topicMetadataMaxAttempts,omitempty"`
+
+	// Logging configuration.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+}
+
+type EntityUserOperatorSpec struct {
+	// The namespace the User Operator should watch.
+	WatchedNamespace *string `json:"watchedNamespace,omitempty"`
+
+	// The image to use for the User Operator.
+	Image *string `json:"image,omitempty"`
+
+	// Interval between periodic reconciliations in milliseconds.
+	ReconciliationIntervalMs *int64 `json:"reconciliationIntervalMs,omitempty"`
+
+	// The prefix that will be added to the KafkaUser name to be used as the
+	// Secret name.
+	SecretPrefix *string `json:"secretPrefix,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Logging configuration.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+}
+
+type CruiseControlSpec struct {
+	// The container image used for Cruise Control pods.
+	Image *string `json:"image,omitempty"`
+
+	// CPU and memory resources to reserve for the Cruise Control container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Pod liveness checking for the Cruise Control container.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking for the Cruise Control container.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// JVM Options for the Cruise Control container.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// Logging configuration (Log4j 2) for Cruise Control.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// Template to specify how Cruise Control resources, `Deployments` and
+	// `Pods`, are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	// The Cruise Control `brokerCapacity` configuration.
+	BrokerCapacity *runtime.RawExtension `json:"brokerCapacity,omitempty"`
+
+	// The Cruise Control configuration.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// Metrics configuration.
+	MetricsConfig *MetricsConfig `json:"metricsConfig,omitempty"`
+
+	// Configuration of the Cruise Control REST API users.
+	APIUsers *runtime.RawExtension `json:"apiUsers,omitempty"`
+
+	// Auto-rebalancing on scaling related configuration listing the modes, when
+	// brokers are added or removed, with the corresponding rebalance template
+	// configurations.
+	AutoRebalance []KafkaAutoRebalanceConfiguration `json:"autoRebalance,omitempty"`
+}
+
+type KafkaAutoRebalanceConfiguration struct {
+	// Specifies the mode for automatically rebalancing when brokers are added or
+	// removed. Supported modes are `add-brokers` and `remove-brokers`.
+	Mode *string `json:"mode,omitempty"`
+
+	// Reference to the KafkaRebalance custom resource to be used as the
+	// configuration template for the auto-rebalancing on scaling when running
+	// for the corresponding mode.
+	Template *corev1.LocalObjectReference `json:"template,omitempty"`
+}
+
+// The status of the Kafka and ZooKeeper clusters, and Topic Operator.
+type KafkaStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Addresses of the internal and external listeners.
+	Listeners []ListenerStatus `json:"listeners,omitempty"`
+
+	// List of the KafkaNodePools used by this Kafka cluster.
+	KafkaNodePools []UsedNodePoolStatus `json:"kafkaNodePools,omitempty"`
+
+	// Registered node IDs used by this Kafka cluster. This field is used for
+	// internal purposes only and will be removed in the future.
+	RegisteredNodeIDs []int32 `json:"registeredNodeIds,omitempty"`
+
+	// Kafka cluster Id.
+	ClusterID *string `json:"clusterId,omitempty"`
+
+	// The version of the Strimzi Cluster Operator which performed the last
+	// successful reconciliation.
+	OperatorLastSuccessfulVersion *string `json:"operatorLastSuccessfulVersion,omitempty"`
+
+	// The version of Kafka currently deployed in the cluster.
+	KafkaVersion *string `json:"kafkaVersion,omitempty"`
+
+	// The KRaft metadata.version currently used by the Kafka cluster.
+	KafkaMetadataVersion *string `json:"kafkaMetadataVersion,omitempty"`
+
+	// Defines where cluster metadata are stored. Possible values are: ZooKeeper
+	// if the metadata are stored in ZooKeeper; KRaftMigration if the controllers
+	// are connected to ZooKeeper, brokers are being rolled with Zookeeper
+	// migration enabled and connection information to controllers, and the
+	// metadata migration process is running; KRaftDualWriting if the metadata
+	// migration process finished and the cluster is in dual-write mode;
+	// KRaftPostMigration if the brokers are fully KRaft-based but controllers
+	// being rolled to disconnect from ZooKeeper; PreKRaft if brokers and
+	// controller are fully KRaft-based, metadata are stored in KRaft, but
+	// ZooKeeper must be deleted; KRaft if the metadata are stored in KRaft.
+	KafkaMetadataState *string `json:"kafkaMetadataState,omitempty"`
+
+	// The status of an auto-rebalancing triggered by a cluster scaling request.
+	AutoRebalance *runtime.RawExtension `json:"autoRebalance,omitempty"`
+}
+
+type ListenerStatus struct {
+	// The name of the listener.
+	Name *string `json:"name,omitempty"`
+
+	// A list of the addresses for this listener.
+	Addresses []ListenerAddress `json:"addresses,omitempty"`
+
+	// A comma-separated list of `host:port` pairs for connecting to the Kafka
+	// cluster using this listener.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+
+	// A list of TLS certificates which can be used to verify the identity of the
+	// server when connecting to the given listener. Set only for `tls` and
+	// `external` listeners.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+type ListenerAddress struct {
+	// The DNS name or IP address of the Kafka bootstrap service.
+	Host *string `json:"host,omitempty"`
+
+	// The port of the Kafka bootstrap service.
+	Port *int32 `json:"port,omitempty"`
+}
+
+type UsedNodePoolStatus struct {
+	// The name of the KafkaNodePool used by this Kafka resource.
+	Name *string `json:"name,omitempty"`
+}
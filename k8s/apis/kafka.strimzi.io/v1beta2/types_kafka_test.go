@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "Kafka",
+  "metadata": {
+    "name": "my-cluster",
+    "annotations": {
+      "strimzi.io/kraft": "enabled",
+      "strimzi.io/node-pools": "enabled"
+    }
+  },
+  "spec": {
+    "kafka": {
+      "version": "3.9.0",
+      "metadataVersion": "3.9-IV0",
+      "listeners": [
+        {"name": "plain", "port": 9092, "type": "internal", "tls": false},
+        {"name": "tls", "port": 9093, "type": "internal", "tls": true, "authentication": {"type": "tls"}}
+      ],
+      "config": {
+        "offsets.topic.replication.factor": 3,
+        "auto.create.topics.enable": false
+      }
+    },
+    "entityOperator": {
+      "topicOperator": {"reconciliationIntervalMs": 60000},
+      "userOperator": {}
+    },
+    "cruiseControl": {}
+  },
+  "status": {
+    "clusterId": "abc",
+    "observedGeneration": 4,
+    "listeners": [
+      {"name": "plain", "bootstrapServers": "my-cluster-kafka-bootstrap.kafka.svc:9092"}
+    ],
+    "conditions": [
+      {"type": "Ready", "status": "True"}
+    ]
+  }
+}
+`
+
+var _ = Describe("Kafka", func() {
+	var kafka v1beta2.Kafka
+	var err error
+
+	BeforeEach(func() {
+		kafka = v1beta2.Kafka{}
+		err = json.Unmarshal([]byte(kafkaJSON), &kafka)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("contains listeners", func() {
+		Expect(kafka.Spec.Kafka.Listeners).To(HaveLen(2))
+		Expect(*kafka.Spec.Kafka.Listeners[1].Name).To(Equal("tls"))
+		Expect(*kafka.Spec.Kafka.Listeners[1].TLS).To(BeTrue())
+	})
+	It("keeps untyped broker config", func() {
+		Expect(kafka.Spec.Kafka.Config).NotTo(BeNil())
+		Expect(
+			string(kafka.Spec.Kafka.Config.Raw),
+		).To(ContainSubstring("offsets.topic.replication.factor"))
+	})
+	It("contains entity operator", func() {
+		Expect(
+			*kafka.Spec.EntityOperator.TopicOperator.ReconciliationIntervalMs,
+		).To(Equal(int64(60000)))
+		Expect(kafka.Spec.EntityOperator.UserOperator).NotTo(BeNil())
+	})
+	It("contains status", func() {
+		Expect(*kafka.Status.ClusterID).To(Equal("abc"))
+		Expect(*kafka.Status.ObservedGeneration).To(Equal(int64(4)))
+		Expect(kafka.Status.Conditions).To(HaveLen(1))
+	})
+	It("is kraft", func() {
+		Expect(kafka.IsKRaft()).To(BeTrue())
+	})
+	It("returns bootstrap servers", func() {
+		Expect(
+			kafka.BootstrapServers("plain"),
+		).To(Equal("my-cluster-kafka-bootstrap.kafka.svc:9092"))
+		Expect(kafka.BootstrapServers("unknown")).To(Equal(""))
+	})
+	It("survives deep copy", func() {
+		copied := kafka.DeepCopy()
+		Expect(copied).To(Equal(&kafka))
+	})
+	Context("without status", func() {
+		BeforeEach(func() {
+			kafka.Status = nil
+		})
+		It("returns empty bootstrap servers", func() {
+			Expect(kafka.BootstrapServers("plain")).To(Equal(""))
+		})
+	})
+	Context("zookeeper based", func() {
+		BeforeEach(func() {
+			kafka.Annotations = nil
+		})
+		It("is not kraft", func() {
+			Expect(kafka.IsKRaft()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type KafkaBridges []KafkaBridge
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaBridge struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka Bridge.
+	Spec *KafkaBridgeSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka Bridge.
+	Status *KafkaBridgeStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaBridgeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaBridge objects.
+	Items []KafkaBridge `json:"items,omitempty"`
+}
+
+type KafkaBridgeSpec struct {
+	// The number of pods in the `Deployment`. Defaults to `1`.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The container image used for Kafka Bridge pods. If no image name is
+	// explicitly specified, the image name corresponds to the image specified in
+	// the Cluster Operator configuration. If an image name is not defined in the
+	// Cluster Operator configuration, a default value is used.
+	Image *string `json:"image,omitempty"`
+
+	// A list of host:port pairs for establishing the initial connection to the
+	// Kafka cluster.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+
+	// TLS configuration for connecting Kafka Bridge to the cluster.
+	TLS *ClientTLS `json:"tls,omitempty"`
+
+	// Authentication configuration for connecting to the cluster.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+
+	// The HTTP related configuration.
+	HTTP *KafkaBridgeHTTPConfig `json:"http,omitempty"`
+
+	// Kafka AdminClient related configuration.
+	AdminClient *KafkaBridgeAdminClientSpec `json:"adminClient,omitempty"`
+
+	// Kafka consumer related configuration.
+	Consumer *KafkaBridgeConsumerSpec `json:"consumer,omitempty"`
+
+	// Kafka producer related configuration.
+	Producer *KafkaBridgeProducerSpec `json:"producer,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// **Currently not supported** JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// Logging configuration for Kafka Bridge.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+
+	// Configuration of the node label which will be used as the client.rack
+	// consumer configuration.
+	Rack *Rack `json:"rack,omitempty"`
+
+	// Enable the metrics for the Kafka Bridge. Default is false.
+	EnableMetrics *bool `json:"enableMetrics,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// Template for Kafka Bridge resources. The template allows users to specify
+	// how a `Deployment` and `Pod` is generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	// The configuration of tracing in Kafka Bridge.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+}
+
+type KafkaBridgeHTTPConfig struct {
+	// The port which is the server listening on.
+	Port *int32 `json:"port,omitempty"`
+
+	// CORS configuration for the HTTP Bridge.
+	Cors *KafkaBridgeHTTPCors `json:"cors,omitempty"`
+}
+
+type KafkaBridgeHTTPCors struct {
+	// List of allowed origins. Java regular expressions can be used.
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+
+	// List of allowed HTTP methods.
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+}
+
+type KafkaBridgeAdminClientSpec struct {
+	// The Kafka AdminClient configuration used for AdminClient instances
+	// created by the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+type KafkaBridgeConsumerSpec struct {
+	// Whether the HTTP consumer should be enabled or disabled. The default is
+	// enabled (`true`).
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The timeout in seconds for deleting inactive consumers, default is -1
+	// (disabled).
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// The Kafka consumer configuration used for consumer instances created by
+	// the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+type KafkaBridgeProducerSpec struct {
+	// Whether the HTTP producer should be enabled or disabled. The default is
+	// enabled (`true`).
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The Kafka producer configuration used for producer instances created by
+	// the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+// The status of the Kafka Bridge.
+type KafkaBridgeStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The URL at which external client applications can access the Kafka
+	// Bridge.
+	URL *string `json:"url,omitempty"`
+
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
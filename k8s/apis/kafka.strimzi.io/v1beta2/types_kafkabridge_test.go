@@ -0,0 +1,54 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaBridgeJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaBridge",
+  "metadata": {"name": "my-bridge"},
+  "spec": {
+    "replicas": 1,
+    "bootstrapServers": "my-cluster-kafka-bootstrap:9092",
+    "http": {"port": 8080, "cors": {"allowedOrigins": ["https://example.com"], "allowedMethods": ["GET", "POST"]}},
+    "consumer": {"enabled": false, "timeoutSeconds": 60},
+    "producer": {"config": {"acks": 1}},
+    "enableMetrics": true
+  },
+  "status": {
+    "url": "http://my-bridge-bridge-service.kafka.svc:8080",
+    "replicas": 1
+  }
+}
+`
+
+var _ = Describe("KafkaBridge", func() {
+	var bridge v1beta2.KafkaBridge
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(kafkaBridgeJSON), &bridge)).To(Succeed())
+	})
+	It("decodes spec", func() {
+		Expect(*bridge.Spec.BootstrapServers).To(Equal("my-cluster-kafka-bootstrap:9092"))
+		Expect(*bridge.Spec.HTTP.Port).To(Equal(int32(8080)))
+		Expect(bridge.Spec.HTTP.Cors.AllowedMethods).To(Equal([]string{"GET", "POST"}))
+		Expect(*bridge.Spec.Consumer.Enabled).To(BeFalse())
+		Expect(*bridge.Spec.Consumer.TimeoutSeconds).To(Equal(int64(60)))
+		Expect(string(bridge.Spec.Producer.Config.Raw)).To(ContainSubstring("acks"))
+		Expect(*bridge.Spec.EnableMetrics).To(BeTrue())
+	})
+	It("decodes status", func() {
+		Expect(*bridge.Status.URL).To(Equal("http://my-bridge-bridge-service.kafka.svc:8080"))
+		Expect(*bridge.Status.Replicas).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,291 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// UseConnectorResourcesAnnotation enables the management of connectors through
+// KafkaConnector resources when set to "true" on a KafkaConnect resource.
+const UseConnectorResourcesAnnotation = "strimzi.io/use-connector-resources"
+
+type KafkaConnects []KafkaConnect
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaConnect struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka Connect cluster.
+	Spec *KafkaConnectSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka Connect cluster.
+	Status *KafkaConnectStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaConnectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaConnect objects.
+	Items []KafkaConnect `json:"items,omitempty"`
+}
+
+type KafkaConnectSpec struct {
+	// The Kafka Connect version. Defaults to the latest version. Consult the user
+	// documentation to understand the process required to upgrade or downgrade
+	// the version.
+	Version *string `json:"version,omitempty"`
+
+	// The number of pods in the Kafka Connect group. Defaults to `3`.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The container image used for Kafka Connect pods. If no image name is
+	// explicitly specified, it is determined based on the `spec.version`
+	// configuration. The image names are specifically mapped to corresponding
+	// versions in the Cluster Operator configuration.
+	Image *string `json:"image,omitempty"`
+
+	// Bootstrap servers to connect to. This should be given as a comma separated
+	// list of _<hostname>_:_<port>_ pairs.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+
+	// TLS configuration.
+	TLS *ClientTLS `json:"tls,omitempty"`
+
+	// Authentication configuration for Kafka Connect.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+
+	// The Kafka Connect configuration.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// The maximum limits for CPU and memory resources and the requested initial
+	// resources.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// JMX Options.
+	JmxOptions *runtime.RawExtension `json:"jmxOptions,omitempty"`
+
+	// Logging configuration for Kafka Connect.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+
+	// Configuration of the node label which will be used as the `client.rack`
+	// consumer configuration.
+	Rack *Rack `json:"rack,omitempty"`
+
+	// Metrics configuration.
+	MetricsConfig *MetricsConfig `json:"metricsConfig,omitempty"`
+
+	// The configuration of tracing in Kafka Connect.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+
+	// Template for Kafka Connect and Kafka Mirror Maker 2 resources. The template
+	// allows users to specify how the `Pods`, `Service`, and other services are
+	// generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	// Pass data from Secrets or ConfigMaps to the Kafka Connect pods and use them
+	// to configure connectors.
+	ExternalConfiguration *ExternalConfiguration `json:"externalConfiguration,omitempty"`
+
+	// Configures how the Connect container image should be built. Optional.
+	Build *Build `json:"build,omitempty"`
+}
+
+// ClientTLS configures TLS trusted certificates for connecting to a Kafka cluster.
+type ClientTLS struct {
+	// Trusted certificates for TLS connection.
+	TrustedCertificates []CertSecretSource `json:"trustedCertificates,omitempty"`
+}
+
+type CertSecretSource struct {
+	// The name of the Secret containing the certificate.
+	SecretName *string `json:"secretName,omitempty"`
+
+	// The name of the file certificate in the secret.
+	Certificate *string `json:"certificate,omitempty"`
+
+	// Pattern for the certificate files in the secret. Use the glob syntax for
+	// the pattern. All files in the secret that match the pattern are used.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+type ExternalConfiguration struct {
+	// Makes data from a Secret or ConfigMap available in the Kafka Connect pods
+	// as environment variables.
+	Env []ExternalConfigurationEnv `json:"env,omitempty"`
+
+	// Makes data from a Secret or ConfigMap available in the Kafka Connect pods
+	// as volumes.
+	Volumes []ExternalConfigurationVolumeSource `json:"volumes,omitempty"`
+}
+
+type ExternalConfigurationEnv struct {
+	// Name of the environment variable which will be passed to the Kafka Connect
+	// pods. The name of the environment variable cannot start with `KAFKA_` or
+	// `STRIMZI_`.
+	Name *string `json:"name,omitempty"`
+
+	// Value of the environment variable which will be passed to the Kafka
+	// Connect pods. It can be passed either as a reference to Secret or
+	// ConfigMap field. The field has to specify exactly one Secret or ConfigMap.
+	ValueFrom *ExternalConfigurationEnvVarSource `json:"valueFrom,omitempty"`
+}
+
+type ExternalConfigurationEnvVarSource struct {
+	// Reference to a key in a Secret.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// Reference to a key in a ConfigMap.
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+type ExternalConfigurationVolumeSource struct {
+	// Name of the volume which will be added to the Kafka Connect pods.
+	Name *string `json:"name,omitempty"`
+
+	// Reference to a key in a Secret. Exactly one Secret or ConfigMap has to be
+	// specified.
+	Secret *corev1.SecretVolumeSource `json:"secret,omitempty"`
+
+	// Reference to a key in a ConfigMap. Exactly one Secret or ConfigMap has to
+	// be specified.
+	ConfigMap *corev1.ConfigMapVolumeSource `json:"configMap,omitempty"`
+}
+
+type Build struct {
+	// Configures where should the newly built image be stored. Required.
+	Output *BuildOutput `json:"output,omitempty"`
+
+	// List of connector plugins which should be added to the Kafka Connect.
+	// Required.
+	Plugins []BuildPlugin `json:"plugins,omitempty"`
+
+	// CPU and memory resources to reserve for the build.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+type BuildOutput struct {
+	// Output type. Must be either `docker` for pushing the newly build image to
+	// Docker compatible registry or `imagestream` for pushing the image to
+	// OpenShift ImageStream. Required.
+	Type *string `json:"type,omitempty"`
+
+	// The name of the image which will be built. Required.
+	Image *string `json:"image,omitempty"`
+
+	// Container Registry Secret with the credentials for pushing the newly built
+	// image.
+	PushSecret *string `json:"pushSecret,omitempty"`
+
+	// Configures additional options which will be passed to the Kaniko executor
+	// when building the new Connect image.
+	AdditionalKanikoOptions []string `json:"additionalKanikoOptions,omitempty"`
+}
+
+type BuildPlugin struct {
+	// The unique name of the connector plugin. Will be used to generate the path
+	// where the connector artifacts will be stored. The name has to be unique
+	// within the KafkaConnect resource.
+	Name *string `json:"name,omitempty"`
+
+	// List of artifacts which belong to this connector plugin. Required.
+	Artifacts []BuildArtifact `json:"artifacts,omitempty"`
+}
+
+type BuildArtifact struct {
+	// Artifact type. Currently, the supported artifact types are `tgz`, `jar`,
+	// `zip`, `other` and `maven`.
+	Type *string `json:"type,omitempty"`
+
+	// URL of the artifact which will be downloaded. Strimzi does not do any
+	// security scanning of the downloaded artifacts. For security reasons, you
+	// should first verify the artifacts manually and configure the checksum
+	// verification to make sure the same artifact is used in the automated
+	// build. Required for `jar`, `zip`, `tgz` and `other` artifacts. Not
+	// applicable to the `maven` artifact type.
+	URL *string `json:"url,omitempty"`
+
+	// SHA512 checksum of the artifact. Optional. If specified, the checksum will
+	// be verified while building the new container. If not specified, the
+	// downloaded artifact will not be verified. Not applicable to the `maven`
+	// artifact type.
+	Sha512sum *string `json:"sha512sum,omitempty"`
+
+	// By default, connections using TLS are verified to check they are secure.
+	// The server certificate used must be valid, trusted, and contain the server
+	// name. By setting this option to `true`, all TLS verification is disabled
+	// and the artifact will be downloaded, even when the server is considered
+	// insecure.
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Maven group id. Applicable to the `maven` artifact type only.
+	Group *string `json:"group,omitempty"`
+
+	// Maven artifact id. Applicable to the `maven` artifact type only.
+	Artifact *string `json:"artifact,omitempty"`
+
+	// Maven version number. Applicable to the `maven` artifact type only.
+	Version *string `json:"version,omitempty"`
+
+	// Maven repository to download the artifact from. Applicable to the `maven`
+	// artifact type only.
+	Repository *string `json:"repository,omitempty"`
+
+	// Name under which the artifact will be stored.
+	FileName *string `json:"fileName,omitempty"`
+}
+
+// The status of the Kafka Connect cluster.
+type KafkaConnectStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The URL of the REST API endpoint for managing and monitoring Kafka Connect
+	// connectors.
+	URL *string `json:"url,omitempty"`
+
+	// The list of connector plugins available in this Kafka Connect deployment.
+	ConnectorPlugins []ConnectorPlugin `json:"connectorPlugins,omitempty"`
+
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+type ConnectorPlugin struct {
+	// The class of the connector plugin.
+	Class *string `json:"class,omitempty"`
+
+	// The type of the connector plugin. The available types are `sink` and
+	// `source`.
+	Type *string `json:"type,omitempty"`
+
+	// The version of the connector plugin.
+	Version *string `json:"version,omitempty"`
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaConnectJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaConnect",
+  "metadata": {
+    "name": "my-connect",
+    "annotations": {"strimzi.io/use-connector-resources": "true"}
+  },
+  "spec": {
+    "replicas": 2,
+    "bootstrapServers": "my-cluster-kafka-bootstrap:9093",
+    "tls": {
+      "trustedCertificates": [{"secretName": "my-cluster-cluster-ca-cert", "pattern": "*.crt"}]
+    },
+    "config": {
+      "group.id": "connect-cluster",
+      "config.storage.replication.factor": -1
+    },
+    "externalConfiguration": {
+      "env": [
+        {"name": "AWS_ACCESS_KEY_ID", "valueFrom": {"secretKeyRef": {"name": "aws", "key": "id"}}}
+      ]
+    },
+    "build": {
+      "output": {"type": "docker", "image": "registry/my-connect:latest"},
+      "plugins": [
+        {
+          "name": "debezium",
+          "artifacts": [
+            {"type": "tgz", "url": "https://example.com/debezium.tgz", "sha512sum": "abc"},
+            {"type": "maven", "group": "org.example", "artifact": "connector", "version": "1.0"}
+          ]
+        }
+      ]
+    }
+  },
+  "status": {
+    "url": "http://my-connect-connect-api:8083",
+    "replicas": 2,
+    "connectorPlugins": [
+      {"class": "org.example.Connector", "type": "source", "version": "1.0"}
+    ]
+  }
+}
+`
+
+const kafkaConnectorJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaConnector",
+  "metadata": {
+    "name": "my-connector",
+    "labels": {"strimzi.io/cluster": "my-connect"}
+  },
+  "spec": {
+    "class": "org.apache.kafka.connect.file.FileStreamSourceConnector",
+    "tasksMax": 2,
+    "state": "paused",
+    "autoRestart": {"enabled": true, "maxRestarts": 10},
+    "config": {"file": "/opt/kafka/LICENSE", "topic": "my-topic"}
+  },
+  "status": {
+    "tasksMax": 2,
+    "topics": ["my-topic"],
+    "connectorStatus": {
+      "name": "my-connector",
+      "connector": {"state": "PAUSED", "worker_id": "10.0.0.1:8083"},
+      "tasks": [
+        {"id": 0, "state": "PAUSED", "worker_id": "10.0.0.1:8083"},
+        {"id": 1, "state": "FAILED", "worker_id": "10.0.0.2:8083", "trace": "boom"}
+      ],
+      "type": "source"
+    }
+  }
+}
+`
+
+var _ = Describe("KafkaConnect", func() {
+	var kafkaConnect v1beta2.KafkaConnect
+	var err error
+
+	BeforeEach(func() {
+		kafkaConnect = v1beta2.KafkaConnect{}
+		err = json.Unmarshal([]byte(kafkaConnectJSON), &kafkaConnect)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("contains spec", func() {
+		Expect(*kafkaConnect.Spec.Replicas).To(Equal(int32(2)))
+		Expect(*kafkaConnect.Spec.BootstrapServers).To(Equal("my-cluster-kafka-bootstrap:9093"))
+		Expect(kafkaConnect.Spec.TLS.TrustedCertificates).To(HaveLen(1))
+		Expect(kafkaConnect.Spec.ExternalConfiguration.Env).To(HaveLen(1))
+		Expect(kafkaConnect.Annotations).To(
+			HaveKeyWithValue(v1beta2.UseConnectorResourcesAnnotation, "true"),
+		)
+	})
+	It("contains build", func() {
+		Expect(*kafkaConnect.Spec.Build.Output.Type).To(Equal("docker"))
+		Expect(kafkaConnect.Spec.Build.Plugins).To(HaveLen(1))
+		Expect(kafkaConnect.Spec.Build.Plugins[0].Artifacts).To(HaveLen(2))
+		Expect(*kafkaConnect.Spec.Build.Plugins[0].Artifacts[1].Artifact).To(Equal("connector"))
+	})
+	It("contains status", func() {
+		Expect(*kafkaConnect.Status.URL).To(Equal("http://my-connect-connect-api:8083"))
+		Expect(kafkaConnect.Status.ConnectorPlugins).To(HaveLen(1))
+	})
+	It("survives deep copy", func() {
+		Expect(kafkaConnect.DeepCopy()).To(Equal(&kafkaConnect))
+	})
+})
+
+var _ = Describe("KafkaConnector", func() {
+	var kafkaConnector v1beta2.KafkaConnector
+	var err error
+
+	BeforeEach(func() {
+		kafkaConnector = v1beta2.KafkaConnector{}
+		err = json.Unmarshal([]byte(kafkaConnectorJSON), &kafkaConnector)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("contains spec", func() {
+		Expect(*kafkaConnector.Spec.TasksMax).To(Equal(int32(2)))
+		Expect(*kafkaConnector.Spec.State).To(Equal(v1beta2.KafkaConnectorStatePaused))
+		Expect(*kafkaConnector.Spec.AutoRestart.Enabled).To(BeTrue())
+		Expect(string(kafkaConnector.Spec.Config.Raw)).To(ContainSubstring("my-topic"))
+	})
+	It("contains connector status", func() {
+		connectorStatus := kafkaConnector.Status.ConnectorStatus
+		Expect(*connectorStatus.Connector.State).To(Equal("PAUSED"))
+		Expect(connectorStatus.Tasks).To(HaveLen(2))
+		Expect(*connectorStatus.Tasks[1].ID).To(Equal(int32(1)))
+		Expect(*connectorStatus.Tasks[1].WorkerID).To(Equal("10.0.0.2:8083"))
+		Expect(*connectorStatus.Tasks[1].Trace).To(Equal("boom"))
+	})
+	It("survives deep copy", func() {
+		Expect(kafkaConnector.DeepCopy()).To(Equal(&kafkaConnector))
+	})
+})
@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// States supported by KafkaConnectorSpec.State.
+const (
+	KafkaConnectorStateRunning = "running"
+	KafkaConnectorStatePaused  = "paused"
+	KafkaConnectorStateStopped = "stopped"
+)
+
+type KafkaConnectors []KafkaConnector
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaConnector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka Connector.
+	Spec *KafkaConnectorSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka Connector.
+	Status *KafkaConnectorStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaConnectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaConnector objects.
+	Items []KafkaConnector `json:"items,omitempty"`
+}
+
+type KafkaConnectorSpec struct {
+	// The Class for the Kafka Connector.
+	Class *string `json:"class,omitempty"`
+
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+
+	// Automatic restart of connector and tasks configuration.
+	AutoRestart *AutoRestart `json:"autoRestart,omitempty"`
+
+	// The Kafka Connector configuration. The following properties cannot be set:
+	// name, connector.class, tasks.max.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// The state the connector should be in. Defaults to running.
+	State *string `json:"state,omitempty"`
+
+	// Configuration for listing offsets.
+	ListOffsets *runtime.RawExtension `json:"listOffsets,omitempty"`
+
+	// Configuration for altering offsets.
+	AlterOffsets *runtime.RawExtension `json:"alterOffsets,omitempty"`
+}
+
+type AutoRestart struct {
+	// Whether automatic restart for failed connectors and tasks should be enabled
+	// or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The maximum number of connector restarts that the operator will try. If
+	// the connector remains in a failed state after reaching this limit, it must
+	// be restarted manually by the user. Defaults to an unlimited number of
+	// restarts.
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+}
+
+// The status of the Kafka Connector.
+type KafkaConnectorStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The auto restart status.
+	AutoRestart *AutoRestartStatus `json:"autoRestart,omitempty"`
+
+	// The connector status, as reported by the Kafka Connect REST API.
+	ConnectorStatus *ConnectorStatus `json:"connectorStatus,omitempty"`
+
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+
+	// The list of topics used by the Kafka Connector.
+	Topics []string `json:"topics,omitempty"`
+}
+
+type AutoRestartStatus struct {
+	// The number of times the connector or task is restarted.
+	Count *int32 `json:"count,omitempty"`
+
+	// The name of the connector being restarted.
+	ConnectorName *string `json:"connectorName,omitempty"`
+
+	// The last time the automatic restart was attempted. The required format is
+	// 'yyyy-MM-ddTHH:mm:ssZ' in the UTC time zone.
+	LastRestartTimestamp *string `json:"lastRestartTimestamp,omitempty"`
+}
+
+// ConnectorStatus is the status of a connector and its tasks as reported by the
+// Kafka Connect REST API.
+type ConnectorStatus struct {
+	// The name of the connector.
+	Name *string `json:"name,omitempty"`
+
+	// The state of the connector.
+	Connector *ConnectorState `json:"connector,omitempty"`
+
+	// The state of the connector tasks.
+	Tasks []ConnectorTaskState `json:"tasks,omitempty"`
+
+	// The type of the connector, either `source` or `sink`.
+	Type *string `json:"type,omitempty"`
+}
+
+type ConnectorState struct {
+	// The state of the connector, for example RUNNING, PAUSED, STOPPED or FAILED.
+	State *string `json:"state,omitempty"`
+
+	// The worker the connector is running on.
+	WorkerID *string `json:"worker_id,omitempty"`
+
+	// The stack trace of the failure, if the connector failed.
+	Trace *string `json:"trace,omitempty"`
+}
+
+type ConnectorTaskState struct {
+	// The id of the task.
+	ID *int32 `json:"id,omitempty"`
+
+	// The state of the task, for example RUNNING, PAUSED or FAILED.
+	State *string `json:"state,omitempty"`
+
+	// The worker the task is running on.
+	WorkerID *string `json:"worker_id,omitempty"`
+
+	// The stack trace of the failure, if the task failed.
+	Trace *string `json:"trace,omitempty"`
+}
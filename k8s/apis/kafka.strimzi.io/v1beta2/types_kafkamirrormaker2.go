@@ -0,0 +1,318 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/bborbe/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// DefaultMirrorTopicsPattern is used by MirrorMaker 2 if no topicsPattern is configured.
+	DefaultMirrorTopicsPattern = ".*"
+	// DefaultMirrorTopicsExcludePattern is used by MirrorMaker 2 if no topicsExcludePattern is configured.
+	DefaultMirrorTopicsExcludePattern = `.*[\-\.]internal,.*\.replica,__.*`
+	// DefaultMirrorGroupsPattern is used by MirrorMaker 2 if no groupsPattern is configured.
+	DefaultMirrorGroupsPattern = ".*"
+	// DefaultMirrorGroupsExcludePattern is used by MirrorMaker 2 if no groupsExcludePattern is configured.
+	DefaultMirrorGroupsExcludePattern = `console-consumer-.*,connect-.*,__.*`
+)
+
+type KafkaMirrorMaker2s []KafkaMirrorMaker2
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaMirrorMaker2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka MirrorMaker 2 cluster.
+	Spec *KafkaMirrorMaker2Spec `json:"spec,omitempty"`
+
+	// The status of the Kafka MirrorMaker 2 cluster.
+	Status *KafkaMirrorMaker2Status `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaMirrorMaker2List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaMirrorMaker2 objects.
+	Items []KafkaMirrorMaker2 `json:"items,omitempty"`
+}
+
+type KafkaMirrorMaker2Spec struct {
+	// The Kafka Connect version. Defaults to the latest version. Consult the user
+	// documentation to understand the process required to upgrade or downgrade
+	// the version.
+	Version *string `json:"version,omitempty"`
+
+	// The number of pods in the Kafka Connect group. Defaults to `3`.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The container image used for Kafka Connect pods. If no image name is
+	// explicitly specified, it is determined based on the `spec.version`
+	// configuration. The image names are specifically mapped to corresponding
+	// versions in the Cluster Operator configuration.
+	Image *string `json:"image,omitempty"`
+
+	// The cluster alias used for Kafka Connect. The value must match the alias
+	// of the *target* Kafka cluster as specified in the `spec.clusters`
+	// configuration. The target Kafka cluster is used by the underlying Kafka
+	// Connect framework for its internal topics.
+	ConnectCluster *string `json:"connectCluster,omitempty"`
+
+	// Kafka clusters for mirroring.
+	Clusters []KafkaMirrorMaker2ClusterSpec `json:"clusters,omitempty"`
+
+	// Configuration of the MirrorMaker 2 connectors.
+	Mirrors []KafkaMirrorMaker2MirrorSpec `json:"mirrors,omitempty"`
+
+	// The maximum limits for CPU and memory resources and the requested initial
+	// resources.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Pod liveness checking.
+	LivenessProbe *Probe `json:"livenessProbe,omitempty"`
+
+	// Pod readiness checking.
+	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// JMX Options.
+	JmxOptions *runtime.RawExtension `json:"jmxOptions,omitempty"`
+
+	// Logging configuration for Kafka Connect.
+	Logging *Logging `json:"logging,omitempty"`
+
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+
+	// Configuration of the node label which will be used as the `client.rack`
+	// consumer configuration.
+	Rack *Rack `json:"rack,omitempty"`
+
+	// Metrics configuration.
+	MetricsConfig *MetricsConfig `json:"metricsConfig,omitempty"`
+
+	// The configuration of tracing in Kafka Connect.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+
+	// Template for Kafka Connect and Kafka Mirror Maker 2 resources. The template
+	// allows users to specify how the `Pods`, `Service`, and other services are
+	// generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	// Pass data from Secrets or ConfigMaps to the Kafka Connect pods and use them
+	// to configure connectors.
+	ExternalConfiguration *ExternalConfiguration `json:"externalConfiguration,omitempty"`
+}
+
+type KafkaMirrorMaker2ClusterSpec struct {
+	// Alias used to reference the Kafka cluster.
+	Alias *string `json:"alias,omitempty"`
+
+	// A comma-separated list of `host:port` pairs for establishing the connection
+	// to the Kafka cluster.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+
+	// TLS configuration for connecting MirrorMaker 2 connectors to a cluster.
+	TLS *ClientTLS `json:"tls,omitempty"`
+
+	// Authentication configuration for connecting to the cluster.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+
+	// The MirrorMaker 2 cluster config. Properties with the following prefixes
+	// cannot be set: ssl., sasl., security., listeners, plugin.path, rest.,
+	// bootstrap.servers, consumer.interceptor.classes,
+	// producer.interceptor.classes.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+type KafkaMirrorMaker2MirrorSpec struct {
+	// The alias of the source cluster used by the Kafka MirrorMaker 2
+	// connectors. The alias must match a cluster in the list at
+	// `spec.clusters`.
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+
+	// The alias of the target cluster used by the Kafka MirrorMaker 2
+	// connectors. The alias must match a cluster in the list at
+	// `spec.clusters`.
+	TargetCluster *string `json:"targetCluster,omitempty"`
+
+	// The specification of the Kafka MirrorMaker 2 source connector.
+	SourceConnector *KafkaMirrorMaker2ConnectorSpec `json:"sourceConnector,omitempty"`
+
+	// The specification of the Kafka MirrorMaker 2 heartbeat connector.
+	HeartbeatConnector *KafkaMirrorMaker2ConnectorSpec `json:"heartbeatConnector,omitempty"`
+
+	// The specification of the Kafka MirrorMaker 2 checkpoint connector.
+	CheckpointConnector *KafkaMirrorMaker2ConnectorSpec `json:"checkpointConnector,omitempty"`
+
+	// A regular expression matching the topics to be mirrored, for example,
+	// "topic1\|topic2\|topic3". Comma-separated lists are also supported.
+	TopicsPattern *string `json:"topicsPattern,omitempty"`
+
+	// A regular expression matching the topics to exclude from mirroring.
+	// Comma-separated lists are also supported.
+	TopicsExcludePattern *string `json:"topicsExcludePattern,omitempty"`
+
+	// A regular expression matching the consumer groups to be mirrored.
+	// Comma-separated lists are also supported.
+	GroupsPattern *string `json:"groupsPattern,omitempty"`
+
+	// A regular expression matching the consumer groups to exclude from
+	// mirroring. Comma-separated lists are also supported.
+	GroupsExcludePattern *string `json:"groupsExcludePattern,omitempty"`
+}
+
+// MatchesTopic returns true if MirrorMaker 2 replicates the given topic name
+// with this mirror. Patterns are applied like MirrorMaker 2 does: the name must
+// fully match one of the comma separated include patterns and none of the
+// exclude patterns. Unset patterns fall back to the MirrorMaker 2 defaults.
+func (m KafkaMirrorMaker2MirrorSpec) MatchesTopic(
+	ctx context.Context,
+	topicName string,
+) (bool, error) {
+	return matchesMirrorPattern(
+		ctx,
+		topicName,
+		valueOrDefault(m.TopicsPattern, DefaultMirrorTopicsPattern),
+		valueOrDefault(m.TopicsExcludePattern, DefaultMirrorTopicsExcludePattern),
+	)
+}
+
+// MatchesGroup returns true if MirrorMaker 2 replicates the offsets of the given
+// consumer group with this mirror.
+func (m KafkaMirrorMaker2MirrorSpec) MatchesGroup(ctx context.Context, group string) (bool, error) {
+	return matchesMirrorPattern(
+		ctx,
+		group,
+		valueOrDefault(m.GroupsPattern, DefaultMirrorGroupsPattern),
+		valueOrDefault(m.GroupsExcludePattern, DefaultMirrorGroupsExcludePattern),
+	)
+}
+
+// MatchingTopics returns all topics whose TopicName is replicated by this mirror.
+func (m KafkaMirrorMaker2MirrorSpec) MatchingTopics(
+	ctx context.Context,
+	topics KafkaTopics,
+) (KafkaTopics, error) {
+	result := KafkaTopics{}
+	for _, topic := range topics {
+		match, err := m.MatchesTopic(ctx, topic.TopicName())
+		if err != nil {
+			return nil, errors.Wrapf(ctx, err, "match topic %s failed", topic.TopicName())
+		}
+		if match {
+			result = append(result, topic)
+		}
+	}
+	return result, nil
+}
+
+func matchesMirrorPattern(
+	ctx context.Context,
+	name string,
+	include string,
+	exclude string,
+) (bool, error) {
+	included, err := matchesAnyPattern(ctx, name, include)
+	if err != nil {
+		return false, errors.Wrapf(ctx, err, "match include pattern failed")
+	}
+	if !included {
+		return false, nil
+	}
+	excluded, err := matchesAnyPattern(ctx, name, exclude)
+	if err != nil {
+		return false, errors.Wrapf(ctx, err, "match exclude pattern failed")
+	}
+	return !excluded, nil
+}
+
+func matchesAnyPattern(ctx context.Context, name string, patterns string) (bool, error) {
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return false, errors.Wrapf(ctx, err, "compile pattern '%s' failed", pattern)
+		}
+		if re.MatchString(name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func valueOrDefault(value *string, defaultValue string) string {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
+
+type KafkaMirrorMaker2ConnectorSpec struct {
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+
+	// The Kafka Connector configuration. The following properties cannot be set:
+	// name, connector.class, tasks.max.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// Automatic restart of connector and tasks configuration.
+	AutoRestart *AutoRestart `json:"autoRestart,omitempty"`
+
+	// The state the connector should be in. Defaults to running.
+	State *string `json:"state,omitempty"`
+
+	// Configuration for listing offsets.
+	ListOffsets *runtime.RawExtension `json:"listOffsets,omitempty"`
+
+	// Configuration for altering offsets.
+	AlterOffsets *runtime.RawExtension `json:"alterOffsets,omitempty"`
+}
+
+// The status of the Kafka MirrorMaker 2 cluster.
+type KafkaMirrorMaker2Status struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The URL of the REST API endpoint for managing and monitoring Kafka Connect
+	// connectors.
+	URL *string `json:"url,omitempty"`
+
+	// List of MirrorMaker 2 connector statuses, as reported by the Kafka Connect
+	// REST API.
+	Connectors []ConnectorStatus `json:"connectors,omitempty"`
+
+	// List of MirrorMaker 2 connector auto restart statuses.
+	AutoRestartStatuses []AutoRestartStatus `json:"autoRestartStatuses,omitempty"`
+
+	// The list of connector plugins available in this Kafka Connect deployment.
+	ConnectorPlugins []ConnectorPlugin `json:"connectorPlugins,omitempty"`
+
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
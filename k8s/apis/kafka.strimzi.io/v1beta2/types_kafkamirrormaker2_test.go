@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaMirrorMaker2JSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaMirrorMaker2",
+  "metadata": {"name": "my-mirror"},
+  "spec": {
+    "replicas": 1,
+    "connectCluster": "target",
+    "clusters": [
+      {"alias": "source", "bootstrapServers": "source-kafka-bootstrap:9092"},
+      {"alias": "target", "bootstrapServers": "target-kafka-bootstrap:9092", "config": {"config.storage.replication.factor": -1}}
+    ],
+    "mirrors": [
+      {
+        "sourceCluster": "source",
+        "targetCluster": "target",
+        "sourceConnector": {"tasksMax": 4, "config": {"replication.factor": -1}},
+        "checkpointConnector": {"config": {"sync.group.offsets.enabled": "true"}},
+        "topicsPattern": "orders.*|payments",
+        "topicsExcludePattern": "orders-internal.*",
+        "groupsPattern": ".*"
+      }
+    ]
+  }
+}
+`
+
+var _ = Describe("KafkaMirrorMaker2", func() {
+	var kafkaMirrorMaker2 v1beta2.KafkaMirrorMaker2
+	var err error
+
+	BeforeEach(func() {
+		kafkaMirrorMaker2 = v1beta2.KafkaMirrorMaker2{}
+		err = json.Unmarshal([]byte(kafkaMirrorMaker2JSON), &kafkaMirrorMaker2)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("contains clusters", func() {
+		Expect(*kafkaMirrorMaker2.Spec.ConnectCluster).To(Equal("target"))
+		Expect(kafkaMirrorMaker2.Spec.Clusters).To(HaveLen(2))
+		Expect(*kafkaMirrorMaker2.Spec.Clusters[0].Alias).To(Equal("source"))
+	})
+	It("contains mirrors", func() {
+		Expect(kafkaMirrorMaker2.Spec.Mirrors).To(HaveLen(1))
+		mirror := kafkaMirrorMaker2.Spec.Mirrors[0]
+		Expect(*mirror.SourceCluster).To(Equal("source"))
+		Expect(*mirror.TargetCluster).To(Equal("target"))
+		Expect(*mirror.SourceConnector.TasksMax).To(Equal(int32(4)))
+		Expect(*mirror.TopicsPattern).To(Equal("orders.*|payments"))
+	})
+	It("survives deep copy", func() {
+		Expect(kafkaMirrorMaker2.DeepCopy()).To(Equal(&kafkaMirrorMaker2))
+	})
+})
+
+var _ = Describe("KafkaMirrorMaker2MirrorSpec", func() {
+	var ctx context.Context
+	var mirror v1beta2.KafkaMirrorMaker2MirrorSpec
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mirror = v1beta2.KafkaMirrorMaker2MirrorSpec{}
+	})
+
+	DescribeTable("MatchesTopic",
+		func(topicsPattern *string, topicsExcludePattern *string, topicName string, expected bool) {
+			mirror.TopicsPattern = topicsPattern
+			mirror.TopicsExcludePattern = topicsExcludePattern
+			match, err := mirror.MatchesTopic(ctx, topicName)
+			Expect(err).To(BeNil())
+			Expect(match).To(Equal(expected))
+		},
+		Entry("default pattern matches", nil, nil, "orders", true),
+		Entry("default exclude internal", nil, nil, "connect-internal", false),
+		Entry("default exclude replica", nil, nil, "orders.replica", false),
+		Entry("default exclude double underscore", nil, nil, "__consumer_offsets", false),
+		Entry("regex matches", collection.Ptr("orders.*"), nil, "orders-eu", true),
+		Entry("regex requires full match", collection.Ptr("orders"), nil, "orders-eu", false),
+		Entry("alternation matches", collection.Ptr("orders|payments"), nil, "payments", true),
+		Entry("comma list matches", collection.Ptr("orders, payments"), nil, "payments", true),
+		Entry("not included", collection.Ptr("orders.*"), nil, "payments", false),
+		Entry(
+			"explicit exclude",
+			collection.Ptr("orders.*"),
+			collection.Ptr("orders-internal.*"),
+			"orders-internal-x",
+			false,
+		),
+		Entry(
+			"empty exclude disables default",
+			collection.Ptr(".*"),
+			collection.Ptr(""),
+			"__consumer_offsets",
+			true,
+		),
+	)
+
+	DescribeTable("MatchesGroup",
+		func(groupsPattern *string, group string, expected bool) {
+			mirror.GroupsPattern = groupsPattern
+			match, err := mirror.MatchesGroup(ctx, group)
+			Expect(err).To(BeNil())
+			Expect(match).To(Equal(expected))
+		},
+		Entry("default pattern matches", nil, "my-group", true),
+		Entry("default exclude console consumer", nil, "console-consumer-123", false),
+		Entry("pattern does not match", collection.Ptr("app-.*"), "my-group", false),
+	)
+
+	Context("invalid pattern", func() {
+		BeforeEach(func() {
+			mirror.TopicsPattern = collection.Ptr("orders(")
+		})
+		It("returns error", func() {
+			_, err := mirror.MatchesTopic(ctx, "orders")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("MatchingTopics", func() {
+		var topics v1beta2.KafkaTopics
+		var result v1beta2.KafkaTopics
+		var err error
+
+		BeforeEach(func() {
+			mirror.TopicsPattern = collection.Ptr("orders.*|payments")
+			topics = v1beta2.KafkaTopics{
+				{ObjectMeta: metav1.ObjectMeta{Name: "orders-eu"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "invoices"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "payments-k8s-name"},
+					Spec:       &v1beta2.KafkaTopicSpec{TopicName: collection.Ptr("payments")},
+				},
+			}
+		})
+		JustBeforeEach(func() {
+			result, err = mirror.MatchingTopics(ctx, topics)
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("returns matching topics by topic name", func() {
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Name).To(Equal("orders-eu"))
+			Expect(result[1].Name).To(Equal("payments-k8s-name"))
+		})
+	})
+})
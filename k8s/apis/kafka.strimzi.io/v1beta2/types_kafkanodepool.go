@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// NextNodeIDsAnnotation defines the node IDs the operator uses for new nodes
+	// when scaling up a KafkaNodePool, for example "[10-20]".
+	NextNodeIDsAnnotation = "strimzi.io/next-node-ids"
+	// RemoveNodeIDsAnnotation defines the node IDs the operator removes first
+	// when scaling down a KafkaNodePool, for example "[3, 4]".
+	RemoveNodeIDsAnnotation = "strimzi.io/remove-node-ids"
+)
+
+// Roles supported by KafkaNodePoolSpec.Roles.
+const (
+	KafkaNodePoolRoleController = "controller"
+	KafkaNodePoolRoleBroker     = "broker"
+)
+
+type KafkaNodePools []KafkaNodePool
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaNodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the KafkaNodePool.
+	Spec *KafkaNodePoolSpec `json:"spec,omitempty"`
+
+	// The status of the KafkaNodePool.
+	Status *KafkaNodePoolStatus `json:"status,omitempty"`
+}
+
+// HasRole returns true if the nodes of the pool are configured with the given role.
+func (k KafkaNodePool) HasRole(role string) bool {
+	if k.Spec == nil {
+		return false
+	}
+	for _, r := range k.Spec.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaNodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaNodePool objects.
+	Items []KafkaNodePool `json:"items,omitempty"`
+}
+
+type KafkaNodePoolSpec struct {
+	// The number of pods in the pool.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Storage configuration (disk). Cannot be updated.
+	Storage *Storage `json:"storage,omitempty"`
+
+	// The roles that the nodes in this pool will have when KRaft mode is
+	// enabled. Supported values are 'broker' and 'controller'. This field is
+	// required. When KRaft mode is disabled, the only allowed value if `broker`.
+	Roles []string `json:"roles,omitempty"`
+
+	// CPU and memory resources to reserve.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// JVM Options for pods.
+	JvmOptions *JvmOptions `json:"jvmOptions,omitempty"`
+
+	// Template for pool resources. The template allows users to specify how the
+	// resources belonging to this pool are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// The status of the KafkaNodePool.
+type KafkaNodePoolStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Node IDs used by Kafka nodes in this pool.
+	NodeIDs []int32 `json:"nodeIds,omitempty"`
+
+	// Kafka cluster ID.
+	ClusterID *string `json:"clusterId,omitempty"`
+
+	// The roles currently assigned to this pool.
+	Roles []string `json:"roles,omitempty"`
+
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaNodePoolJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaNodePool",
+  "metadata": {
+    "name": "broker",
+    "labels": {"strimzi.io/cluster": "my-cluster"},
+    "annotations": {"strimzi.io/next-node-ids": "[10-20]"}
+  },
+  "spec": {
+    "replicas": 3,
+    "roles": ["broker"],
+    "storage": {
+      "type": "jbod",
+      "volumes": [{"id": 0, "type": "persistent-claim", "size": "100Gi", "kraftMetadata": "shared"}]
+    },
+    "resources": {"requests": {"memory": "4Gi"}}
+  },
+  "status": {
+    "nodeIds": [0, 1, 2],
+    "clusterId": "abc",
+    "roles": ["broker"],
+    "replicas": 3
+  }
+}
+`
+
+var _ = Describe("KafkaNodePool", func() {
+	var nodePool v1beta2.KafkaNodePool
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(kafkaNodePoolJSON), &nodePool)).To(Succeed())
+	})
+	It("decodes spec", func() {
+		Expect(*nodePool.Spec.Replicas).To(Equal(int32(3)))
+		Expect(*nodePool.Spec.Storage.Type).To(Equal("jbod"))
+		Expect(nodePool.Spec.Storage.Volumes).To(HaveLen(1))
+		Expect(*nodePool.Spec.Storage.Volumes[0].KraftMetadata).To(Equal("shared"))
+		Expect(nodePool.Spec.Resources.Requests.Memory().String()).To(Equal("4Gi"))
+		Expect(nodePool.Annotations).To(HaveKeyWithValue(v1beta2.NextNodeIDsAnnotation, "[10-20]"))
+	})
+	It("decodes status", func() {
+		Expect(nodePool.Status.NodeIDs).To(Equal([]int32{0, 1, 2}))
+		Expect(*nodePool.Status.ClusterID).To(Equal("abc"))
+	})
+	It("returns the configured roles", func() {
+		Expect(nodePool.HasRole(v1beta2.KafkaNodePoolRoleBroker)).To(BeTrue())
+		Expect(nodePool.HasRole(v1beta2.KafkaNodePoolRoleController)).To(BeFalse())
+		Expect(v1beta2.KafkaNodePool{}.HasRole(v1beta2.KafkaNodePoolRoleBroker)).To(BeFalse())
+	})
+})
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bborbe/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// RebalanceAnnotation triggers actions on a KafkaRebalance resource.
+	RebalanceAnnotation = "strimzi.io/rebalance"
+	// RebalanceAutoApprovalAnnotation approves proposals automatically when set to "true".
+	RebalanceAutoApprovalAnnotation = "strimzi.io/rebalance-auto-approval"
+)
+
+// Values supported by the RebalanceAnnotation.
+const (
+	RebalanceActionApprove  = "approve"
+	RebalanceActionRefresh  = "refresh"
+	RebalanceActionStop     = "stop"
+	RebalanceActionTemplate = "template"
+)
+
+// Modes supported by KafkaRebalanceSpec.Mode.
+const (
+	KafkaRebalanceModeFull          = "full"
+	KafkaRebalanceModeAddBrokers    = "add-brokers"
+	KafkaRebalanceModeRemoveBrokers = "remove-brokers"
+	KafkaRebalanceModeRemoveDisks   = "remove-disks"
+)
+
+// KafkaRebalanceState is the state of a KafkaRebalance. The operator reports it
+// as the type of the condition with status True.
+type KafkaRebalanceState string
+
+// States reported by the operator for a KafkaRebalance.
+const (
+	KafkaRebalanceStateNew                  KafkaRebalanceState = "New"
+	KafkaRebalanceStatePendingProposal      KafkaRebalanceState = "PendingProposal"
+	KafkaRebalanceStateProposalReady        KafkaRebalanceState = "ProposalReady"
+	KafkaRebalanceStateRebalancing          KafkaRebalanceState = "Rebalancing"
+	KafkaRebalanceStateStopped              KafkaRebalanceState = "Stopped"
+	KafkaRebalanceStateNotReady             KafkaRebalanceState = "NotReady"
+	KafkaRebalanceStateReady                KafkaRebalanceState = "Ready"
+	KafkaRebalanceStateReconciliationPaused KafkaRebalanceState = "ReconciliationPaused"
+)
+
+// String returns the state as string.
+func (s KafkaRebalanceState) String() string {
+	return string(s)
+}
+
+type KafkaRebalances []KafkaRebalance
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaRebalance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the Kafka rebalance.
+	Spec *KafkaRebalanceSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka rebalance.
+	Status *KafkaRebalanceStatus `json:"status,omitempty"`
+}
+
+// State returns the current state of the rebalance or an empty state if the
+// operator has not reported one yet. Warning conditions are ignored.
+func (k KafkaRebalance) State() KafkaRebalanceState {
+	if k.Status == nil {
+		return ""
+	}
+	for _, condition := range k.Status.Conditions {
+		if condition.Type == nil || *condition.Type == "Warning" {
+			continue
+		}
+		if condition.Status != nil && *condition.Status == "True" {
+			return KafkaRebalanceState(*condition.Type)
+		}
+	}
+	return ""
+}
+
+// Condition returns the condition of the current state.
+func (k KafkaRebalance) Condition() *Condition {
+	state := k.State()
+	if state == "" {
+		return nil
+	}
+	for _, condition := range k.Status.Conditions {
+		if condition.Type != nil && *condition.Type == state.String() {
+			return &condition
+		}
+	}
+	return nil
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaRebalanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaRebalance objects.
+	Items []KafkaRebalance `json:"items,omitempty"`
+}
+
+type KafkaRebalanceSpec struct {
+	// Mode to run the rebalancing. The supported modes are `full`,
+	// `add-brokers`, `remove-brokers`, `remove-disks`. If not specified, the
+	// `full` mode is used by default.
+	Mode *string `json:"mode,omitempty"`
+
+	// The list of newly added brokers in case of scaling up or the ones to be
+	// removed in case of scaling down to use for rebalancing. This list can be
+	// used only with rebalancing mode `add-brokers` and `removed-brokers`. It
+	// is ignored with `full` mode.
+	Brokers []int32 `json:"brokers,omitempty"`
+
+	// A list of goals, ordered by decreasing priority, to use for generating and
+	// executing the rebalance proposal. The supported goals are available at
+	// https://github.com/linkedin/cruise-control#goals. If an empty goals list
+	// is provided, the goals declared in the default.goals Cruise Control
+	// configuration parameter are used.
+	Goals []string `json:"goals,omitempty"`
+
+	// Whether to allow the hard goals specified in the Kafka CR to be skipped in
+	// optimization proposal generation. This can be useful when some of those
+	// hard goals are preventing a balance solution being found. Default is
+	// false.
+	SkipHardGoalCheck *bool `json:"skipHardGoalCheck,omitempty"`
+
+	// Enables intra-broker disk balancing, which balances disk space
+	// utilization between disks on the same broker. Only applies to Kafka
+	// deployments that use JBOD storage with multiple disks. When enabled,
+	// inter-broker balancing is disabled. Default is false.
+	RebalanceDisk *bool `json:"rebalanceDisk,omitempty"`
+
+	// A regular expression where any matching topics will be excluded from the
+	// calculation of optimization proposals. This expression will be parsed by
+	// the java.util.regex.Pattern class; for more information on the supported
+	// format consult the documentation for that class.
+	ExcludedTopics *string `json:"excludedTopics,omitempty"`
+
+	// The upper bound of ongoing partition replica movements going into/out of
+	// each broker. Default is 5.
+	ConcurrentPartitionMovementsPerBroker *int32 `json:"concurrentPartitionMovementsPerBroker,omitempty"`
+
+	// The upper bound of ongoing partition replica movements between disks
+	// within each broker. Default is 2.
+	ConcurrentIntraBrokerPartitionMovements *int32 `json:"concurrentIntraBrokerPartitionMovements,omitempty"`
+
+	// The upper bound of ongoing partition leadership movements. Default is
+	// 1000.
+	ConcurrentLeaderMovements *int32 `json:"concurrentLeaderMovements,omitempty"`
+
+	// The upper bound, in bytes per second, on the bandwidth used to move
+	// replicas. There is no limit by default.
+	ReplicationThrottle *int64 `json:"replicationThrottle,omitempty"`
+
+	// A list of strategy class names used to determine the execution order for
+	// the replica movements in the generated optimization proposal. By default
+	// BaseReplicaMovementStrategy is used, which will execute the replica
+	// movements in the order that they were generated.
+	ReplicaMovementStrategies []string `json:"replicaMovementStrategies,omitempty"`
+
+	// List of brokers and their corresponding volumes from which replicas need
+	// to be moved.
+	MoveReplicasOffVolumes []BrokerAndVolumeIDs `json:"moveReplicasOffVolumes,omitempty"`
+}
+
+type BrokerAndVolumeIDs struct {
+	// ID of the broker that contains the disk from which you want to move the
+	// partition replicas.
+	BrokerID *int32 `json:"brokerId,omitempty"`
+
+	// IDs of the disks from which the partition replicas need to be moved.
+	VolumeIDs []int32 `json:"volumeIds,omitempty"`
+}
+
+// The status of the Kafka rebalance.
+type KafkaRebalanceStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The session identifier for requests to Cruise Control pertaining to this
+	// KafkaRebalance resource. This is used by the Kafka Rebalance operator to
+	// track the status of ongoing rebalancing operations.
+	SessionID *string `json:"sessionId,omitempty"`
+
+	// A JSON object describing the optimization result.
+	OptimizationResult *runtime.RawExtension `json:"optimizationResult,omitempty"`
+}
+
+// ParseOptimizationResult decodes the optimization result reported by Cruise
+// Control. It returns nil if no proposal is available yet.
+func (s KafkaRebalanceStatus) ParseOptimizationResult(
+	ctx context.Context,
+) (map[string]interface{}, error) {
+	if s.OptimizationResult == nil || len(s.OptimizationResult.Raw) == 0 {
+		return nil, nil
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(s.OptimizationResult.Raw, &result); err != nil {
+		return nil, errors.Wrapf(ctx, err, "unmarshal optimization result failed")
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaRebalanceJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaRebalance",
+  "metadata": {
+    "name": "my-rebalance",
+    "labels": {"strimzi.io/cluster": "my-cluster"}
+  },
+  "spec": {
+    "mode": "add-brokers",
+    "brokers": [3, 4],
+    "goals": ["RackAwareGoal", "ReplicaCapacityGoal"],
+    "moveReplicasOffVolumes": [{"brokerId": 0, "volumeIds": [1, 2]}]
+  },
+  "status": {
+    "observedGeneration": 1,
+    "sessionId": "abc-123",
+    "conditions": [
+      {"type": "Warning", "status": "True", "reason": "SomeWarning"},
+      {"type": "ProposalReady", "status": "True"}
+    ],
+    "optimizationResult": {
+      "numReplicaMovements": 12,
+      "dataToMoveMB": 42
+    }
+  }
+}
+`
+
+var _ = Describe("KafkaRebalance", func() {
+	var ctx context.Context
+	var rebalance v1beta2.KafkaRebalance
+	BeforeEach(func() {
+		ctx = context.Background()
+		Expect(json.Unmarshal([]byte(kafkaRebalanceJSON), &rebalance)).To(Succeed())
+	})
+	It("decodes spec", func() {
+		Expect(*rebalance.Spec.Mode).To(Equal(v1beta2.KafkaRebalanceModeAddBrokers))
+		Expect(rebalance.Spec.Brokers).To(Equal([]int32{3, 4}))
+		Expect(rebalance.Spec.Goals).To(HaveLen(2))
+		Expect(rebalance.Spec.MoveReplicasOffVolumes).To(HaveLen(1))
+		Expect(rebalance.Spec.MoveReplicasOffVolumes[0].VolumeIDs).To(Equal([]int32{1, 2}))
+	})
+	It("decodes status", func() {
+		Expect(*rebalance.Status.SessionID).To(Equal("abc-123"))
+		Expect(*rebalance.Status.ObservedGeneration).To(Equal(int64(1)))
+	})
+	Context("State", func() {
+		It("ignores warning conditions", func() {
+			Expect(rebalance.State()).To(Equal(v1beta2.KafkaRebalanceStateProposalReady))
+		})
+		It("returns empty state without status", func() {
+			rebalance.Status = nil
+			Expect(rebalance.State()).To(BeEmpty())
+		})
+	})
+	Context("Condition", func() {
+		It("returns the condition of the current state", func() {
+			condition := rebalance.Condition()
+			Expect(condition).NotTo(BeNil())
+			Expect(*condition.Type).To(Equal("ProposalReady"))
+		})
+		It("returns nil without status", func() {
+			rebalance.Status = nil
+			Expect(rebalance.Condition()).To(BeNil())
+		})
+	})
+	Context("ParseOptimizationResult", func() {
+		It("decodes the optimization result", func() {
+			result, err := rebalance.Status.ParseOptimizationResult(ctx)
+			Expect(err).To(BeNil())
+			Expect(result).To(HaveKeyWithValue("numReplicaMovements", float64(12)))
+			Expect(result).To(HaveKeyWithValue("dataToMoveMB", float64(42)))
+		})
+		It("returns nil without optimization result", func() {
+			result, err := v1beta2.KafkaRebalanceStatus{}.ParseOptimizationResult(ctx)
+			Expect(err).To(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
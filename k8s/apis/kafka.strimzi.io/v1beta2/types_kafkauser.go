@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Authentication types supported by KafkaUserAuthentication.
+const (
+	KafkaUserAuthenticationTLS         = "tls"
+	KafkaUserAuthenticationTLSExternal = "tls-external"
+	KafkaUserAuthenticationScramSha512 = "scram-sha-512"
+)
+
+// Resource types supported by AclRuleResource.
+const (
+	AclResourceTypeTopic           = "topic"
+	AclResourceTypeGroup           = "group"
+	AclResourceTypeCluster         = "cluster"
+	AclResourceTypeTransactionalID = "transactionalId"
+)
+
+// Pattern types supported by AclRuleResource.
+const (
+	AclPatternTypeLiteral = "literal"
+	AclPatternTypePrefix  = "prefix"
+)
+
+// Operations supported by AclRule.
+const (
+	AclOperationRead            = "Read"
+	AclOperationWrite           = "Write"
+	AclOperationCreate          = "Create"
+	AclOperationDelete          = "Delete"
+	AclOperationAlter           = "Alter"
+	AclOperationDescribe        = "Describe"
+	AclOperationClusterAction   = "ClusterAction"
+	AclOperationAlterConfigs    = "AlterConfigs"
+	AclOperationDescribeConfigs = "DescribeConfigs"
+	AclOperationIdempotentWrite = "IdempotentWrite"
+	AclOperationAll             = "All"
+)
+
+type KafkaUsers []KafkaUser
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The specification of the user.
+	Spec *KafkaUserSpec `json:"spec,omitempty"`
+
+	// The status of the Kafka User.
+	Status *KafkaUserStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KafkaUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// A list of KafkaUser objects.
+	Items []KafkaUser `json:"items,omitempty"`
+}
+
+type KafkaUserSpec struct {
+	// Authentication mechanism enabled for this Kafka user. The supported
+	// authentication mechanisms are `scram-sha-512`, `tls`, and `tls-external`.
+	Authentication *KafkaUserAuthentication `json:"authentication,omitempty"`
+
+	// Authorization rules for this Kafka user.
+	Authorization *KafkaUserAuthorization `json:"authorization,omitempty"`
+
+	// Quotas on requests to control the broker resources used by clients.
+	// Network bandwidth and request rate quotas can be enforced.
+	Quotas *KafkaUserQuotas `json:"quotas,omitempty"`
+
+	// Template to specify how Kafka User `Secrets` are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+type KafkaUserAuthentication struct {
+	// Authentication type.
+	Type *string `json:"type,omitempty"`
+
+	// Specify the password for the user. If not set, a new password is generated
+	// by the User Operator. Only used with type `scram-sha-512`.
+	Password *Password `json:"password,omitempty"`
+}
+
+type Password struct {
+	// Secret from which the password should be read.
+	ValueFrom *PasswordSource `json:"valueFrom,omitempty"`
+}
+
+type PasswordSource struct {
+	// Selects a key of a Secret in the resource's namespace.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+type KafkaUserAuthorization struct {
+	// Authorization type. Currently the only supported type is `simple`. `simple`
+	// authorization type uses the Kafka Admin API for managing the ACL rules.
+	Type *string `json:"type,omitempty"`
+
+	// List of ACL rules which should be applied to this user.
+	Acls []AclRule `json:"acls,omitempty"`
+}
+
+type AclRule struct {
+	// Indicates the resource for which given ACL rule applies.
+	Resource *AclRuleResource `json:"resource,omitempty"`
+
+	// The type of the rule. ACL rules with type `allow` are used to allow user to
+	// execute the specified operations. ACL rules with type `deny` are used to
+	// deny user to execute the specified operations. Default value is `allow`.
+	Type *string `json:"type,omitempty"`
+
+	// List of operations to allow or deny. Supported operations are: Read,
+	// Write, Create, Delete, Alter, Describe, ClusterAction, AlterConfigs,
+	// DescribeConfigs, IdempotentWrite and All. Only certain operations work
+	// with the specified resource.
+	Operations []string `json:"operations,omitempty"`
+
+	// The host from which the action described in the ACL rule is allowed or
+	// denied. If not set, it defaults to `*`, allowing or denying the action from
+	// any host.
+	Host *string `json:"host,omitempty"`
+}
+
+type AclRuleResource struct {
+	// Resource type. The available resource types are `topic`, `group`,
+	// `cluster`, and `transactionalId`.
+	Type *string `json:"type,omitempty"`
+
+	// Name of resource for which given ACL rule applies. Can be combined with
+	// `patternType` field to use prefix pattern. Not used for type `cluster`.
+	Name *string `json:"name,omitempty"`
+
+	// Describes the pattern used in the resource field. The supported types are
+	// `literal` and `prefix`. With `literal` pattern type, the resource field
+	// will be used as a definition of a full name. With `prefix` pattern type,
+	// the resource name will be used only as a prefix. Default value is
+	// `literal`.
+	PatternType *string `json:"patternType,omitempty"`
+}
+
+type KafkaUserQuotas struct {
+	// A quota on the maximum bytes per-second that each client group can publish
+	// to a broker before the clients in the group are throttled. Defined on a
+	// per-broker basis.
+	ProducerByteRate *int32 `json:"producerByteRate,omitempty"`
+
+	// A quota on the maximum bytes per-second that each client group can fetch
+	// from a broker before the clients in the group are throttled. Defined on a
+	// per-broker basis.
+	ConsumerByteRate *int32 `json:"consumerByteRate,omitempty"`
+
+	// A quota on the maximum CPU utilization of each client group as a
+	// percentage of network and I/O threads.
+	RequestPercentage *int32 `json:"requestPercentage,omitempty"`
+
+	// A quota on the rate at which mutations are accepted for the create topics
+	// request, the create partitions request and the delete topics request. The
+	// rate is accumulated by the number of partitions created or deleted.
+	ControllerMutationRate *float64 `json:"controllerMutationRate,omitempty"`
+}
+
+// The status of the Kafka User.
+type KafkaUserStatus struct {
+	// List of status conditions.
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Username.
+	Username *string `json:"username,omitempty"`
+
+	// The name of `Secret` where the credentials are stored.
+	Secret *string `json:"secret,omitempty"`
+}
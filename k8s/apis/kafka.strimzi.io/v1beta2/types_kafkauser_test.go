@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+const kafkaUserJSON = `
+{
+  "apiVersion": "kafka.strimzi.io/v1beta2",
+  "kind": "KafkaUser",
+  "metadata": {
+    "name": "my-user",
+    "labels": {
+      "strimzi.io/cluster": "my-cluster"
+    }
+  },
+  "spec": {
+    "authentication": {
+      "type": "scram-sha-512",
+      "password": {
+        "valueFrom": {
+          "secretKeyRef": {"name": "my-secret", "key": "password"}
+        }
+      }
+    },
+    "authorization": {
+      "type": "simple",
+      "acls": [
+        {
+          "resource": {"type": "topic", "name": "my-topic", "patternType": "literal"},
+          "operations": ["Describe", "Read"],
+          "host": "*"
+        },
+        {
+          "resource": {"type": "group", "name": "my-group", "patternType": "prefix"},
+          "operations": ["Read"]
+        }
+      ]
+    },
+    "quotas": {
+      "producerByteRate": 1048576,
+      "consumerByteRate": 2097152,
+      "requestPercentage": 55,
+      "controllerMutationRate": 10.5
+    }
+  },
+  "status": {
+    "username": "my-user",
+    "secret": "my-user",
+    "observedGeneration": 2,
+    "conditions": [
+      {"type": "Ready", "status": "True"}
+    ]
+  }
+}
+`
+
+var _ = Describe("KafkaUser", func() {
+	var kafkaUser v1beta2.KafkaUser
+	var err error
+
+	BeforeEach(func() {
+		kafkaUser = v1beta2.KafkaUser{}
+		err = json.Unmarshal([]byte(kafkaUserJSON), &kafkaUser)
+	})
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("contains authentication", func() {
+		Expect(*kafkaUser.Spec.Authentication.Type).To(
+			Equal(v1beta2.KafkaUserAuthenticationScramSha512),
+		)
+		secretKeyRef := kafkaUser.Spec.Authentication.Password.ValueFrom.SecretKeyRef
+		Expect(secretKeyRef.Name).To(Equal("my-secret"))
+		Expect(secretKeyRef.Key).To(Equal("password"))
+	})
+	It("contains acls", func() {
+		Expect(kafkaUser.Spec.Authorization.Acls).To(HaveLen(2))
+		acl := kafkaUser.Spec.Authorization.Acls[0]
+		Expect(*acl.Resource.Type).To(Equal(v1beta2.AclResourceTypeTopic))
+		Expect(*acl.Resource.Name).To(Equal("my-topic"))
+		Expect(*acl.Resource.PatternType).To(Equal(v1beta2.AclPatternTypeLiteral))
+		Expect(acl.Operations).To(Equal([]string{
+			v1beta2.AclOperationDescribe,
+			v1beta2.AclOperationRead,
+		}))
+		Expect(*acl.Host).To(Equal("*"))
+	})
+	It("contains quotas", func() {
+		Expect(*kafkaUser.Spec.Quotas.ProducerByteRate).To(Equal(int32(1048576)))
+		Expect(*kafkaUser.Spec.Quotas.ConsumerByteRate).To(Equal(int32(2097152)))
+		Expect(*kafkaUser.Spec.Quotas.RequestPercentage).To(Equal(int32(55)))
+		Expect(*kafkaUser.Spec.Quotas.ControllerMutationRate).To(Equal(10.5))
+	})
+	It("contains status", func() {
+		Expect(*kafkaUser.Status.Username).To(Equal("my-user"))
+		Expect(*kafkaUser.Status.Secret).To(Equal("my-user"))
+		Expect(kafkaUser.Status.Conditions).To(HaveLen(1))
+	})
+	It("survives deep copy", func() {
+		copied := kafkaUser.DeepCopy()
+		Expect(copied).To(Equal(&kafkaUser))
+	})
+})
@@ -5,6 +5,9 @@
 package v1beta2_test
 
 import (
+	"context"
+	"time"
+
 	"github.com/bborbe/collection"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -229,8 +232,8 @@ var _ = Describe("KafkaTopic", func() {
 				kafkaTopic1.Spec.Config = map[string]string{}
 				kafkaTopic2.Spec.Config = nil
 			})
-			It("returns false", func() {
-				Expect(isEqual).To(BeFalse())
+			It("returns true", func() {
+				Expect(isEqual).To(BeTrue())
 			})
 		})
 
@@ -259,6 +262,140 @@ var _ = Describe("KafkaTopic", func() {
 	})
 })
 
+var _ = Describe("KafkaTopic equality", func() {
+	topic := func(partitions *int32, replicas *int32, config map[string]string) v1beta2.KafkaTopic {
+		return v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-topic"},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: partitions,
+				Replicas:   replicas,
+				Config:     config,
+			},
+		}
+	}
+
+	DescribeTable("Equal",
+		func(a v1beta2.KafkaTopic, b v1beta2.KafkaTopic, expected bool) {
+			Expect(a.Equal(b)).To(Equal(expected))
+			Expect(b.Equal(a)).To(Equal(expected))
+		},
+		Entry("same values in different pointers",
+			topic(collection.Ptr(int32(3)), collection.Ptr(int32(2)), nil),
+			topic(collection.Ptr(int32(3)), collection.Ptr(int32(2)), nil),
+			true,
+		),
+		Entry("different partitions",
+			topic(collection.Ptr(int32(3)), nil, nil),
+			topic(collection.Ptr(int32(6)), nil, nil),
+			false,
+		),
+		Entry("broker default partitions vs explicit",
+			topic(nil, nil, nil),
+			topic(collection.Ptr(int32(1)), nil, nil),
+			false,
+		),
+		Entry("broker default replicas vs explicit",
+			topic(nil, nil, nil),
+			topic(nil, collection.Ptr(int32(1)), nil),
+			false,
+		),
+		Entry("nil spec vs empty spec",
+			v1beta2.KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "test-topic"}},
+			topic(nil, nil, map[string]string{}),
+			true,
+		),
+		Entry("number with leading zero and sign",
+			topic(nil, nil, map[string]string{"retention.ms": "604800000"}),
+			topic(nil, nil, map[string]string{"retention.ms": " +0604800000 "}),
+			true,
+		),
+		Entry("different numbers",
+			topic(nil, nil, map[string]string{"retention.ms": "604800000"}),
+			topic(nil, nil, map[string]string{"retention.ms": "86400000"}),
+			false,
+		),
+		Entry("unit suffix is not a number",
+			topic(nil, nil, map[string]string{"retention.ms": "604800000"}),
+			topic(nil, nil, map[string]string{"retention.ms": "7d"}),
+			false,
+		),
+		Entry("boolean case",
+			topic(nil, nil, map[string]string{"unclean.leader.election.enable": "false"}),
+			topic(nil, nil, map[string]string{"unclean.leader.election.enable": "FALSE"}),
+			true,
+		),
+		Entry("cleanup.policy order",
+			topic(nil, nil, map[string]string{"cleanup.policy": "compact,delete"}),
+			topic(nil, nil, map[string]string{"cleanup.policy": "delete, compact"}),
+			true,
+		),
+		Entry("ratio notation",
+			topic(nil, nil, map[string]string{"min.cleanable.dirty.ratio": "0.5"}),
+			topic(nil, nil, map[string]string{"min.cleanable.dirty.ratio": "0.50"}),
+			true,
+		),
+		Entry("missing config key",
+			topic(nil, nil, map[string]string{"retention.ms": "604800000"}),
+			topic(nil, nil, nil),
+			false,
+		),
+	)
+
+	DescribeTable("NormalizeConfigValue",
+		func(key string, value string, expected string) {
+			Expect(v1beta2.NormalizeConfigValue(key, value)).To(Equal(expected))
+		},
+		Entry("number", "retention.ms", "+0604800000", "604800000"),
+		Entry("negative number", "retention.ms", "-1", "-1"),
+		Entry("boolean", "preallocate", "True", "true"),
+		Entry("set", "cleanup.policy", "delete,compact,delete", "compact,delete"),
+		Entry("ratio", "min.cleanable.dirty.ratio", "0.50", "0.5"),
+		Entry("string", "compression.type", " lz4 ", "lz4"),
+	)
+
+	Context("Diff", func() {
+		var diff v1beta2.KafkaTopicDiff
+
+		BeforeEach(func() {
+			current := topic(collection.Ptr(int32(3)), collection.Ptr(int32(2)), map[string]string{
+				"cleanup.policy": "delete",
+				"retention.ms":   "604800000",
+			})
+			desired := topic(collection.Ptr(int32(6)), nil, map[string]string{
+				"retention.ms":        "+604800000",
+				"min.insync.replicas": "2",
+			})
+			desired.Spec.TopicName = collection.Ptr("renamed")
+			diff = current.Diff(desired)
+		})
+		It("is not empty", func() {
+			Expect(diff.IsEmpty()).To(BeFalse())
+		})
+		It("reports the topic name", func() {
+			Expect(
+				diff.TopicName,
+			).To(Equal(&v1beta2.StringChange{Old: "test-topic", New: "renamed"}))
+		})
+		It("reports the partitions", func() {
+			Expect(diff.Partitions.String()).To(Equal("3 -> 6"))
+		})
+		It("reports the replicas reset to the broker default", func() {
+			Expect(diff.Replicas.String()).To(Equal("2 -> default"))
+		})
+		It("reports only config changes Kafka sees", func() {
+			Expect(diff.Config).To(Equal([]v1beta2.KeyChange{
+				{Key: "cleanup.policy", Type: v1beta2.KeyChangeTypeRemoved, Old: "delete"},
+				{Key: "min.insync.replicas", Type: v1beta2.KeyChangeTypeAdded, New: "2"},
+			}))
+		})
+		It("is empty for equal topics", func() {
+			a := topic(collection.Ptr(int32(3)), nil, nil)
+			b := topic(collection.Ptr(int32(3)), nil, map[string]string{})
+			Expect(a.Diff(b).IsEmpty()).To(BeTrue())
+		})
+	})
+})
+
 var _ = Describe("KafkaTopicSpec", func() {
 	Context("creation", func() {
 		It("can be created with all fields", func() {
@@ -320,6 +457,126 @@ var _ = Describe("KafkaTopicStatus", func() {
 	})
 })
 
+var _ = Describe("KafkaTopicStatus helpers", func() {
+	var ctx context.Context
+	var status *v1beta2.KafkaTopicStatus
+	BeforeEach(func() {
+		ctx = context.Background()
+		status = &v1beta2.KafkaTopicStatus{
+			ObservedGeneration: collection.Ptr(int32(2)),
+			Conditions: []v1beta2.KafkaTopicStatusConditionsElem{
+				{
+					Type:               collection.Ptr("Ready"),
+					Status:             collection.Ptr("True"),
+					LastTransitionTime: collection.Ptr("2026-01-02T03:04:05Z"),
+				},
+			},
+		}
+	})
+
+	DescribeTable("IsReconciled",
+		func(observedGeneration *int32, generation int64, expected bool) {
+			status.ObservedGeneration = observedGeneration
+			Expect(status.IsReconciled(generation)).To(Equal(expected))
+		},
+		Entry("observed equals generation", collection.Ptr(int32(2)), int64(2), true),
+		Entry("observed above generation", collection.Ptr(int32(3)), int64(2), true),
+		Entry("observed below generation", collection.Ptr(int32(1)), int64(2), false),
+		Entry("observed generation missing", nil, int64(0), false),
+	)
+
+	DescribeTable("IsReady",
+		func(conditions []v1beta2.KafkaTopicStatusConditionsElem, expected bool) {
+			status.Conditions = conditions
+			Expect(status.IsReady()).To(Equal(expected))
+		},
+		Entry("ready", []v1beta2.KafkaTopicStatusConditionsElem{
+			{Type: collection.Ptr("Ready"), Status: collection.Ptr("True")},
+		}, true),
+		Entry("not ready", []v1beta2.KafkaTopicStatusConditionsElem{
+			{Type: collection.Ptr("Ready"), Status: collection.Ptr("False")},
+		}, false),
+		Entry("other condition", []v1beta2.KafkaTopicStatusConditionsElem{
+			{Type: collection.Ptr("Unmanaged"), Status: collection.Ptr("True")},
+		}, false),
+		Entry("condition without fields", []v1beta2.KafkaTopicStatusConditionsElem{{}}, false),
+		Entry("no conditions", nil, false),
+	)
+
+	It("GetCondition returns the condition", func() {
+		condition := status.GetCondition(v1beta2.KafkaTopicConditionReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.IsTrue()).To(BeTrue())
+		Expect(condition.IsFalse()).To(BeFalse())
+	})
+
+	It("GetCondition returns nil for a missing condition", func() {
+		Expect(status.GetCondition(v1beta2.KafkaTopicConditionWarning)).To(BeNil())
+	})
+
+	It("UnmanagedWarning returns the message of the unmanaged condition", func() {
+		status.Conditions = append(status.Conditions, v1beta2.KafkaTopicStatusConditionsElem{
+			Type:    collection.Ptr("Unmanaged"),
+			Status:  collection.Ptr("True"),
+			Reason:  collection.Ptr("Unmanaged"),
+			Message: collection.Ptr("strimzi.io/managed is false"),
+		})
+		warning, ok := status.UnmanagedWarning()
+		Expect(ok).To(BeTrue())
+		Expect(warning).To(Equal("Unmanaged: strimzi.io/managed is false"))
+	})
+
+	It("UnmanagedWarning returns a default message", func() {
+		status.Conditions = []v1beta2.KafkaTopicStatusConditionsElem{
+			{Type: collection.Ptr("Unmanaged"), Status: collection.Ptr("True")},
+		}
+		warning, ok := status.UnmanagedWarning()
+		Expect(ok).To(BeTrue())
+		Expect(warning).NotTo(BeEmpty())
+	})
+
+	It("UnmanagedWarning returns false for a managed topic", func() {
+		_, ok := status.UnmanagedWarning()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("parses the last transition time", func() {
+		lastTransitionTime, err := status.GetCondition("Ready").ParseLastTransitionTime(ctx)
+		Expect(err).To(BeNil())
+		Expect(lastTransitionTime).To(Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)))
+	})
+
+	It("returns an error for an invalid last transition time", func() {
+		status.Conditions[0].LastTransitionTime = collection.Ptr("yesterday")
+		_, err := status.GetCondition("Ready").ParseLastTransitionTime(ctx)
+		Expect(err).NotTo(BeNil())
+	})
+
+	Context("nil status", func() {
+		BeforeEach(func() {
+			status = nil
+		})
+		It("is nil-safe", func() {
+			Expect(status.IsReady()).To(BeFalse())
+			Expect(status.IsReconciled(0)).To(BeFalse())
+			Expect(status.GetCondition("Ready")).To(BeNil())
+			_, ok := status.UnmanagedWarning()
+			Expect(ok).To(BeFalse())
+		})
+		It("returns zero values for the missing condition", func() {
+			condition := status.GetCondition("Ready")
+			Expect(condition.GetType()).To(Equal(""))
+			Expect(condition.GetStatus()).To(Equal(""))
+			Expect(condition.GetReason()).To(Equal(""))
+			Expect(condition.GetMessage()).To(Equal(""))
+			Expect(condition.String()).To(Equal(""))
+			lastTransitionTime, err := condition.ParseLastTransitionTime(ctx)
+			Expect(err).To(BeNil())
+			Expect(lastTransitionTime.IsZero()).To(BeTrue())
+		})
+	})
+})
+
 var _ = Describe("KafkaTopicStatusConditionsElem", func() {
 	Context("creation", func() {
 		It("can be created with all fields", func() {
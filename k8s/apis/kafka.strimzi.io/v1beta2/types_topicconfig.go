@@ -0,0 +1,438 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+// CleanupPolicy is a value of the cleanup.policy topic config.
+type CleanupPolicy string
+
+const (
+	CleanupPolicyDelete  CleanupPolicy = "delete"
+	CleanupPolicyCompact CleanupPolicy = "compact"
+)
+
+// CompressionType is a value of the compression.type topic config.
+type CompressionType string
+
+const (
+	CompressionTypeUncompressed CompressionType = "uncompressed"
+	CompressionTypeZstd         CompressionType = "zstd"
+	CompressionTypeLz4          CompressionType = "lz4"
+	CompressionTypeSnappy       CompressionType = "snappy"
+	CompressionTypeGzip         CompressionType = "gzip"
+	CompressionTypeProducer     CompressionType = "producer"
+)
+
+// MessageTimestampType is a value of the message.timestamp.type topic config.
+type MessageTimestampType string
+
+const (
+	MessageTimestampTypeCreateTime    MessageTimestampType = "CreateTime"
+	MessageTimestampTypeLogAppendTime MessageTimestampType = "LogAppendTime"
+)
+
+// TopicConfig is the typed form of KafkaTopicSpec.Config with the topic-level configs
+// of Kafka. Nil fields are not set and defaulted by the broker.
+type TopicConfig struct {
+	CleanupPolicy                   []CleanupPolicy
+	CompressionType                 *CompressionType
+	CompressionGzipLevel            *int32
+	CompressionLz4Level             *int32
+	CompressionZstdLevel            *int32
+	DeleteRetentionMs               *int64
+	FileDeleteDelayMs               *int64
+	FlushMessages                   *int64
+	FlushMs                         *int64
+	FollowerReplicationThrottled    *string
+	IndexIntervalBytes              *int32
+	LeaderReplicationThrottled      *string
+	LocalRetentionBytes             *int64
+	LocalRetentionMs                *int64
+	MaxCompactionLagMs              *int64
+	MaxMessageBytes                 *int32
+	MessageDownconversionEnable     *bool
+	MessageFormatVersion            *string
+	MessageTimestampAfterMaxMs      *int64
+	MessageTimestampBeforeMaxMs     *int64
+	MessageTimestampDifferenceMaxMs *int64
+	MessageTimestampType            *MessageTimestampType
+	MinCleanableDirtyRatio          *float64
+	MinCompactionLagMs              *int64
+	MinInsyncReplicas               *int32
+	Preallocate                     *bool
+	RemoteLogCopyDisable            *bool
+	RemoteLogDeleteOnDisable        *bool
+	RemoteStorageEnable             *bool
+	RetentionBytes                  *int64
+	RetentionMs                     *int64
+	SegmentBytes                    *int32
+	SegmentIndexBytes               *int32
+	SegmentJitterMs                 *int64
+	SegmentMs                       *int64
+	UncleanLeaderElectionEnable     *bool
+	// Other holds the keys that are no known topic-level config. Validate rejects them.
+	Other map[string]string
+}
+
+// ParseTopicConfig converts the config of a KafkaTopicSpec into a TopicConfig.
+// It fails with all keys whose value can not be parsed, sorted by key. Unknown keys
+// are kept in Other.
+func ParseTopicConfig(ctx context.Context, config map[string]string) (TopicConfig, error) {
+	var result TopicConfig
+	fields := result.fields()
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var problems []string
+	for _, key := range keys {
+		value := config[key]
+		field, ok := fields[key]
+		if !ok {
+			if result.Other == nil {
+				result.Other = map[string]string{}
+			}
+			result.Other[key] = value
+			continue
+		}
+		if err := field.parse(strings.TrimSpace(value)); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", key, err))
+		}
+	}
+	if len(problems) > 0 {
+		return TopicConfig{}, errors.Errorf(
+			ctx,
+			"parse topic config failed: %s",
+			strings.Join(problems, ", "),
+		)
+	}
+	return result, nil
+}
+
+// ValidateTopicConfig parses and validates the config of a KafkaTopicSpec.
+func ValidateTopicConfig(ctx context.Context, config map[string]string) error {
+	topicConfig, err := ParseTopicConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+	return topicConfig.Validate(ctx)
+}
+
+// Map converts the TopicConfig into the config of a KafkaTopicSpec. It returns nil if
+// nothing is set.
+func (t TopicConfig) Map() map[string]string {
+	var result map[string]string
+	set := func(key string, value string) {
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[key] = value
+	}
+	for key, value := range t.Other {
+		set(key, value)
+	}
+	for key, field := range t.fields() {
+		if value, ok := field.format(); ok {
+			set(key, value)
+		}
+	}
+	return result
+}
+
+// Validate returns an error listing all unknown keys, invalid enum values and numbers
+// out of the range Kafka accepts.
+func (t TopicConfig) Validate(ctx context.Context) error {
+	var problems []string
+	for key := range t.Other {
+		problems = append(problems, fmt.Sprintf("unknown key %s", key))
+	}
+	for key, field := range t.fields() {
+		if problem := field.validate(); problem != "" {
+			problems = append(problems, fmt.Sprintf("%s %s", key, problem))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.Errorf(ctx, "invalid topic config: %s", strings.Join(problems, ", "))
+}
+
+// +k8s:deepcopy-gen=false
+type topicConfigField struct {
+	parse    func(value string) error
+	format   func() (string, bool)
+	validate func() string
+}
+
+// fields returns the known keys bound to the fields of t. The ranges follow the
+// validators of the Kafka LogConfig.
+func (t *TopicConfig) fields() map[string]topicConfigField {
+	return map[string]topicConfigField{
+		"cleanup.policy": enumListField(
+			&t.CleanupPolicy,
+			CleanupPolicyDelete,
+			CleanupPolicyCompact,
+		),
+		"compression.type": enumField(
+			&t.CompressionType,
+			CompressionTypeUncompressed,
+			CompressionTypeZstd,
+			CompressionTypeLz4,
+			CompressionTypeSnappy,
+			CompressionTypeGzip,
+			CompressionTypeProducer,
+		),
+		"compression.gzip.level":                  gzipLevelField(&t.CompressionGzipLevel),
+		"compression.lz4.level":                   int32Field(&t.CompressionLz4Level, 1, 17),
+		"compression.zstd.level":                  int32Field(&t.CompressionZstdLevel, -131072, 22),
+		"delete.retention.ms":                     int64Field(&t.DeleteRetentionMs, 0),
+		"file.delete.delay.ms":                    int64Field(&t.FileDeleteDelayMs, 0),
+		"flush.messages":                          int64Field(&t.FlushMessages, 1),
+		"flush.ms":                                int64Field(&t.FlushMs, 0),
+		"follower.replication.throttled.replicas": stringField(&t.FollowerReplicationThrottled),
+		"index.interval.bytes": int32Field(
+			&t.IndexIntervalBytes,
+			0,
+			math.MaxInt32,
+		),
+		"leader.replication.throttled.replicas": stringField(&t.LeaderReplicationThrottled),
+		"local.retention.bytes":                 int64Field(&t.LocalRetentionBytes, -2),
+		"local.retention.ms":                    int64Field(&t.LocalRetentionMs, -2),
+		"max.compaction.lag.ms":                 int64Field(&t.MaxCompactionLagMs, 1),
+		"max.message.bytes":                     int32Field(&t.MaxMessageBytes, 0, math.MaxInt32),
+		"message.downconversion.enable":         boolField(&t.MessageDownconversionEnable),
+		"message.format.version":                stringField(&t.MessageFormatVersion),
+		"message.timestamp.after.max.ms":        int64Field(&t.MessageTimestampAfterMaxMs, 0),
+		"message.timestamp.before.max.ms":       int64Field(&t.MessageTimestampBeforeMaxMs, 0),
+		"message.timestamp.difference.max.ms": int64Field(
+			&t.MessageTimestampDifferenceMaxMs,
+			0,
+		),
+		"message.timestamp.type": enumField(
+			&t.MessageTimestampType,
+			MessageTimestampTypeCreateTime,
+			MessageTimestampTypeLogAppendTime,
+		),
+		"min.cleanable.dirty.ratio":      ratioField(&t.MinCleanableDirtyRatio),
+		"min.compaction.lag.ms":          int64Field(&t.MinCompactionLagMs, 0),
+		"min.insync.replicas":            int32Field(&t.MinInsyncReplicas, 1, math.MaxInt32),
+		"preallocate":                    boolField(&t.Preallocate),
+		"remote.log.copy.disable":        boolField(&t.RemoteLogCopyDisable),
+		"remote.log.delete.on.disable":   boolField(&t.RemoteLogDeleteOnDisable),
+		"remote.storage.enable":          boolField(&t.RemoteStorageEnable),
+		"retention.bytes":                int64Field(&t.RetentionBytes, -1),
+		"retention.ms":                   int64Field(&t.RetentionMs, -1),
+		"segment.bytes":                  int32Field(&t.SegmentBytes, 14, math.MaxInt32),
+		"segment.index.bytes":            int32Field(&t.SegmentIndexBytes, 4, math.MaxInt32),
+		"segment.jitter.ms":              int64Field(&t.SegmentJitterMs, 0),
+		"segment.ms":                     int64Field(&t.SegmentMs, 1),
+		"unclean.leader.election.enable": boolField(&t.UncleanLeaderElectionEnable),
+	}
+}
+
+func int64Field(value **int64, minValue int64) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			*value = &v
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return strconv.FormatInt(**value, 10), true
+		},
+		validate: func() string {
+			if *value != nil && **value < minValue {
+				return fmt.Sprintf("must be at least %d", minValue)
+			}
+			return ""
+		},
+	}
+}
+
+func int32Field(value **int32, minValue int32, maxValue int32) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			v, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return err
+			}
+			i := int32(v)
+			*value = &i
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return strconv.FormatInt(int64(**value), 10), true
+		},
+		validate: func() string {
+			if *value != nil && (**value < minValue || **value > maxValue) {
+				return fmt.Sprintf("must be between %d and %d", minValue, maxValue)
+			}
+			return ""
+		},
+	}
+}
+
+// gzipLevelField accepts -1 for the default level or 1 to 9.
+func gzipLevelField(value **int32) topicConfigField {
+	field := int32Field(value, 1, 9)
+	validate := field.validate
+	field.validate = func() string {
+		if *value != nil && **value == -1 {
+			return ""
+		}
+		return validate()
+	}
+	return field
+}
+
+func ratioField(value **float64) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return err
+			}
+			*value = &v
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return strconv.FormatFloat(**value, 'g', -1, 64), true
+		},
+		validate: func() string {
+			if *value != nil && (**value < 0 || **value > 1) {
+				return "must be between 0 and 1"
+			}
+			return ""
+		},
+	}
+}
+
+func boolField(value **bool) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			// Kafka only accepts true and false, ignoring case
+			if !strings.EqualFold(s, "true") && !strings.EqualFold(s, "false") {
+				return fmt.Errorf("invalid boolean %q", s)
+			}
+			v := strings.EqualFold(s, "true")
+			*value = &v
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return strconv.FormatBool(**value), true
+		},
+		validate: func() string {
+			return ""
+		},
+	}
+}
+
+func stringField(value **string) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			*value = &s
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return **value, true
+		},
+		validate: func() string {
+			return ""
+		},
+	}
+}
+
+func enumField[T ~string](value **T, allowed ...T) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			v := T(s)
+			*value = &v
+			return nil
+		},
+		format: func() (string, bool) {
+			if *value == nil {
+				return "", false
+			}
+			return string(**value), true
+		},
+		validate: func() string {
+			if *value != nil && !slices.Contains(allowed, **value) {
+				return fmt.Sprintf(
+					"has invalid value %s, must be one of %s",
+					**value,
+					join(allowed),
+				)
+			}
+			return ""
+		},
+	}
+}
+
+func enumListField[T ~string](values *[]T, allowed ...T) topicConfigField {
+	return topicConfigField{
+		parse: func(s string) error {
+			*values = nil
+			for _, v := range strings.Split(s, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					*values = append(*values, T(v))
+				}
+			}
+			return nil
+		},
+		format: func() (string, bool) {
+			if len(*values) == 0 {
+				return "", false
+			}
+			return join(*values), true
+		},
+		validate: func() string {
+			for _, v := range *values {
+				if !slices.Contains(allowed, v) {
+					return fmt.Sprintf("has invalid value %s, must be one of %s", v, join(allowed))
+				}
+			}
+			return ""
+		},
+	}
+}
+
+func join[T ~string](values []T) string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = string(v)
+	}
+	return strings.Join(result, ",")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package v1beta2_test
+
+import (
+	"context"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("TopicConfig", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Context("ParseTopicConfig", func() {
+		var topicConfig v1beta2.TopicConfig
+		var err error
+		var config map[string]string
+
+		BeforeEach(func() {
+			config = map[string]string{
+				"cleanup.policy":                 "compact, delete",
+				"compression.type":               "lz4",
+				"min.cleanable.dirty.ratio":      "0.5",
+				"min.insync.replicas":            "2",
+				"retention.ms":                   "604800000",
+				"unclean.leader.election.enable": "FALSE",
+			}
+		})
+		JustBeforeEach(func() {
+			topicConfig, err = v1beta2.ParseTopicConfig(ctx, config)
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("parses the values", func() {
+			Expect(topicConfig.CleanupPolicy).To(Equal([]v1beta2.CleanupPolicy{
+				v1beta2.CleanupPolicyCompact,
+				v1beta2.CleanupPolicyDelete,
+			}))
+			Expect(*topicConfig.CompressionType).To(Equal(v1beta2.CompressionTypeLz4))
+			Expect(*topicConfig.MinCleanableDirtyRatio).To(Equal(0.5))
+			Expect(*topicConfig.MinInsyncReplicas).To(Equal(int32(2)))
+			Expect(*topicConfig.RetentionMs).To(Equal(int64(604800000)))
+			Expect(*topicConfig.UncleanLeaderElectionEnable).To(BeFalse())
+			Expect(topicConfig.SegmentBytes).To(BeNil())
+			Expect(topicConfig.Other).To(BeNil())
+		})
+		It("converts back to an equal map", func() {
+			Expect(topicConfig.Map()).To(Equal(map[string]string{
+				"cleanup.policy":                 "compact,delete",
+				"compression.type":               "lz4",
+				"min.cleanable.dirty.ratio":      "0.5",
+				"min.insync.replicas":            "2",
+				"retention.ms":                   "604800000",
+				"unclean.leader.election.enable": "false",
+			}))
+		})
+		Context("unknown key", func() {
+			BeforeEach(func() {
+				config["retention.msec"] = "1"
+			})
+			It("keeps it in other", func() {
+				Expect(err).To(BeNil())
+				Expect(topicConfig.Other).To(Equal(map[string]string{"retention.msec": "1"}))
+				Expect(topicConfig.Map()).To(HaveKeyWithValue("retention.msec", "1"))
+			})
+		})
+		Context("number is invalid", func() {
+			BeforeEach(func() {
+				config["retention.ms"] = "7d"
+			})
+			It("returns an error", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("retention.ms"))
+			})
+		})
+		Context("int is out of range", func() {
+			BeforeEach(func() {
+				config["segment.bytes"] = "4294967296"
+			})
+			It("returns an error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("boolean is invalid", func() {
+			BeforeEach(func() {
+				config["preallocate"] = "yes"
+			})
+			It("returns an error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+
+	It("returns a nil map if nothing is set", func() {
+		Expect(v1beta2.TopicConfig{}.Map()).To(BeNil())
+	})
+
+	It("converts a typed config into a map", func() {
+		topicConfig := v1beta2.TopicConfig{
+			CleanupPolicy:        []v1beta2.CleanupPolicy{v1beta2.CleanupPolicyCompact},
+			MessageTimestampType: collection.Ptr(v1beta2.MessageTimestampTypeLogAppendTime),
+			RetentionBytes:       collection.Ptr(int64(-1)),
+		}
+		Expect(topicConfig.Map()).To(Equal(map[string]string{
+			"cleanup.policy":         "compact",
+			"message.timestamp.type": "LogAppendTime",
+			"retention.bytes":        "-1",
+		}))
+	})
+
+	DescribeTable(
+		"ValidateTopicConfig",
+		func(config map[string]string, expectedProblem string) {
+			err := v1beta2.ValidateTopicConfig(ctx, config)
+			if expectedProblem == "" {
+				Expect(err).To(BeNil())
+				return
+			}
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring(expectedProblem))
+		},
+		Entry("empty", nil, ""),
+		Entry("valid", map[string]string{
+			"cleanup.policy":      "compact",
+			"retention.ms":        "-1",
+			"segment.bytes":       "1073741824",
+			"min.insync.replicas": "2",
+		}, ""),
+		Entry(
+			"unknown key",
+			map[string]string{"retention.msec": "1"},
+			"unknown key retention.msec",
+		),
+		Entry("invalid cleanup.policy",
+			map[string]string{"cleanup.policy": "compacted"},
+			"cleanup.policy has invalid value compacted, must be one of delete,compact",
+		),
+		Entry("invalid compression.type",
+			map[string]string{"compression.type": "brotli"},
+			"compression.type has invalid value brotli",
+		),
+		Entry("invalid message.timestamp.type",
+			map[string]string{"message.timestamp.type": "createtime"},
+			"message.timestamp.type has invalid value createtime",
+		),
+		Entry("retention.ms below -1",
+			map[string]string{"retention.ms": "-2"},
+			"retention.ms must be at least -1",
+		),
+		Entry("min.insync.replicas zero",
+			map[string]string{"min.insync.replicas": "0"},
+			"min.insync.replicas must be between 1 and 2147483647",
+		),
+		Entry("segment.bytes too small",
+			map[string]string{"segment.bytes": "10"},
+			"segment.bytes must be between 14",
+		),
+		Entry("min.cleanable.dirty.ratio above 1",
+			map[string]string{"min.cleanable.dirty.ratio": "1.5"},
+			"min.cleanable.dirty.ratio must be between 0 and 1",
+		),
+		Entry("gzip default level", map[string]string{"compression.gzip.level": "-1"}, ""),
+		Entry("gzip level out of range",
+			map[string]string{"compression.gzip.level": "0"},
+			"compression.gzip.level must be between 1 and 9",
+		),
+		Entry("value not parseable", map[string]string{"segment.ms": "1h"}, "segment.ms"),
+	)
+
+	It("reports all values that can not be parsed sorted by key", func() {
+		_, err := v1beta2.ParseTopicConfig(ctx, map[string]string{
+			"segment.ms":   "1h",
+			"retention.ms": "7d",
+		})
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(MatchRegexp(`retention\.ms: .*7d.*, segment\.ms: .*1h`))
+	})
+
+	It("reports all problems", func() {
+		err := v1beta2.ValidateTopicConfig(ctx, map[string]string{
+			"retention.msec": "1",
+			"cleanup.policy": "compacted",
+		})
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown key retention.msec"))
+		Expect(err.Error()).To(ContainSubstring("cleanup.policy has invalid value compacted"))
+	})
+})
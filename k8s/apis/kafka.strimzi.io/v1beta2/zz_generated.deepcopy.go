@@ -9,173 +9,415 @@
 package v1beta2
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+func (in *AclRule) DeepCopyInto(out *AclRule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(KafkaTopicSpec)
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(AclRuleResource)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(KafkaTopicStatus)
-		(*in).DeepCopyInto(*out)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
-func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AclRule.
+func (in *AclRule) DeepCopy() *AclRule {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopic)
+	out := new(AclRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopic) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AclRuleResource) DeepCopyInto(out *AclRuleResource) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
 	}
-	return nil
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.PatternType != nil {
+		in, out := &in.PatternType, &out.PatternType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AclRuleResource.
+func (in *AclRuleResource) DeepCopy() *AclRuleResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AclRuleResource)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+func (in *AutoRestart) DeepCopyInto(out *AutoRestart) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KafkaTopic, len(*in))
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRestart.
+func (in *AutoRestart) DeepCopy() *AutoRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRestartStatus) DeepCopyInto(out *AutoRestartStatus) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectorName != nil {
+		in, out := &in.ConnectorName, &out.ConnectorName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastRestartTimestamp != nil {
+		in, out := &in.LastRestartTimestamp, &out.LastRestartTimestamp
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRestartStatus.
+func (in *AutoRestartStatus) DeepCopy() *AutoRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerAndVolumeIDs) DeepCopyInto(out *BrokerAndVolumeIDs) {
+	*out = *in
+	if in.BrokerID != nil {
+		in, out := &in.BrokerID, &out.BrokerID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VolumeIDs != nil {
+		in, out := &in.VolumeIDs, &out.VolumeIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerAndVolumeIDs.
+func (in *BrokerAndVolumeIDs) DeepCopy() *BrokerAndVolumeIDs {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerAndVolumeIDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Build) DeepCopyInto(out *Build) {
+	*out = *in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(BuildOutput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]BuildPlugin, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
-func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Build.
+func (in *Build) DeepCopy() *Build {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicList)
+	out := new(Build)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildArtifact) DeepCopyInto(out *BuildArtifact) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
 	}
-	return nil
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sha512sum != nil {
+		in, out := &in.Sha512sum, &out.Sha512sum
+		*out = new(string)
+		**out = **in
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+	if in.FileName != nil {
+		in, out := &in.FileName, &out.FileName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildArtifact.
+func (in *BuildArtifact) DeepCopy() *BuildArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildArtifact)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+func (in *BuildOutput) DeepCopyInto(out *BuildOutput) {
 	*out = *in
-	if in.Config != nil {
-		in, out := &in.Config, &out.Config
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Partitions != nil {
-		in, out := &in.Partitions, &out.Partitions
-		*out = new(int32)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
 		**out = **in
 	}
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
 		**out = **in
 	}
-	if in.TopicName != nil {
-		in, out := &in.TopicName, &out.TopicName
+	if in.PushSecret != nil {
+		in, out := &in.PushSecret, &out.PushSecret
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalKanikoOptions != nil {
+		in, out := &in.AdditionalKanikoOptions, &out.AdditionalKanikoOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
-func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildOutput.
+func (in *BuildOutput) DeepCopy() *BuildOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicSpec)
+	out := new(BuildOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in KafkaTopicSpecs) DeepCopyInto(out *KafkaTopicSpecs) {
-	{
-		in := &in
-		*out = make(KafkaTopicSpecs, len(*in))
+func (in *BuildPlugin) DeepCopyInto(out *BuildPlugin) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]BuildArtifact, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
-		return
 	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpecs.
-func (in KafkaTopicSpecs) DeepCopy() KafkaTopicSpecs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlugin.
+func (in *BuildPlugin) DeepCopy() *BuildPlugin {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicSpecs)
+	out := new(BuildPlugin)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+func (in *CertSecretSource) DeepCopyInto(out *CertSecretSource) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]KafkaTopicStatusConditionsElem, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.SecretName != nil {
+		in, out := &in.SecretName, &out.SecretName
+		*out = new(string)
+		**out = **in
 	}
-	if in.ObservedGeneration != nil {
-		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertSecretSource.
+func (in *CertSecretSource) DeepCopy() *CertSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CertSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthority) DeepCopyInto(out *CertificateAuthority) {
+	*out = *in
+	if in.GenerateCertificateAuthority != nil {
+		in, out := &in.GenerateCertificateAuthority, &out.GenerateCertificateAuthority
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GenerateSecretOwnerReference != nil {
+		in, out := &in.GenerateSecretOwnerReference, &out.GenerateSecretOwnerReference
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ValidityDays != nil {
+		in, out := &in.ValidityDays, &out.ValidityDays
 		*out = new(int32)
 		**out = **in
 	}
-	if in.TopicName != nil {
-		in, out := &in.TopicName, &out.TopicName
+	if in.RenewalDays != nil {
+		in, out := &in.RenewalDays, &out.RenewalDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CertificateExpirationPolicy != nil {
+		in, out := &in.CertificateExpirationPolicy, &out.CertificateExpirationPolicy
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
-func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthority.
+func (in *CertificateAuthority) DeepCopy() *CertificateAuthority {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicStatus)
+	out := new(CertificateAuthority)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopicStatusConditionsElem) DeepCopyInto(out *KafkaTopicStatusConditionsElem) {
+func (in *ClientTLS) DeepCopyInto(out *ClientTLS) {
+	*out = *in
+	if in.TrustedCertificates != nil {
+		in, out := &in.TrustedCertificates, &out.TrustedCertificates
+		*out = make([]CertSecretSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientTLS.
+func (in *ClientTLS) DeepCopy() *ClientTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
 	if in.LastTransitionTime != nil {
 		in, out := &in.LastTransitionTime, &out.LastTransitionTime
@@ -205,34 +447,3794 @@ func (in *KafkaTopicStatusConditionsElem) DeepCopyInto(out *KafkaTopicStatusCond
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatusConditionsElem.
-func (in *KafkaTopicStatusConditionsElem) DeepCopy() *KafkaTopicStatusConditionsElem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopicStatusConditionsElem)
+	out := new(Condition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in KafkaTopics) DeepCopyInto(out *KafkaTopics) {
+func (in *ConnectorPlugin) DeepCopyInto(out *ConnectorPlugin) {
+	*out = *in
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorPlugin.
+func (in *ConnectorPlugin) DeepCopy() *ConnectorPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorState) DeepCopyInto(out *ConnectorState) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerID != nil {
+		in, out := &in.WorkerID, &out.WorkerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorState.
+func (in *ConnectorState) DeepCopy() *ConnectorState {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorStatus) DeepCopyInto(out *ConnectorStatus) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Connector != nil {
+		in, out := &in.Connector, &out.Connector
+		*out = new(ConnectorState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]ConnectorTaskState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorStatus.
+func (in *ConnectorStatus) DeepCopy() *ConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectorTaskState) DeepCopyInto(out *ConnectorTaskState) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.WorkerID != nil {
+		in, out := &in.WorkerID, &out.WorkerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorTaskState.
+func (in *ConnectorTaskState) DeepCopy() *ConnectorTaskState {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectorTaskState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CruiseControlSpec) DeepCopyInto(out *CruiseControlSpec) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BrokerCapacity != nil {
+		in, out := &in.BrokerCapacity, &out.BrokerCapacity
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIUsers != nil {
+		in, out := &in.APIUsers, &out.APIUsers
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRebalance != nil {
+		in, out := &in.AutoRebalance, &out.AutoRebalance
+		*out = make([]KafkaAutoRebalanceConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CruiseControlSpec.
+func (in *CruiseControlSpec) DeepCopy() *CruiseControlSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CruiseControlSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntityOperatorSpec) DeepCopyInto(out *EntityOperatorSpec) {
+	*out = *in
+	if in.TopicOperator != nil {
+		in, out := &in.TopicOperator, &out.TopicOperator
+		*out = new(EntityTopicOperatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserOperator != nil {
+		in, out := &in.UserOperator, &out.UserOperator
+		*out = new(EntityUserOperatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSSidecar != nil {
+		in, out := &in.TLSSidecar, &out.TLSSidecar
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityOperatorSpec.
+func (in *EntityOperatorSpec) DeepCopy() *EntityOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EntityOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntityTopicOperatorSpec) DeepCopyInto(out *EntityTopicOperatorSpec) {
+	*out = *in
+	if in.WatchedNamespace != nil {
+		in, out := &in.WatchedNamespace, &out.WatchedNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReconciliationIntervalMs != nil {
+		in, out := &in.ReconciliationIntervalMs, &out.ReconciliationIntervalMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ZookeeperSessionTimeoutSeconds != nil {
+		in, out := &in.ZookeeperSessionTimeoutSeconds, &out.ZookeeperSessionTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicMetadataMaxAttempts != nil {
+		in, out := &in.TopicMetadataMaxAttempts, &out.TopicMetadataMaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityTopicOperatorSpec.
+func (in *EntityTopicOperatorSpec) DeepCopy() *EntityTopicOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EntityTopicOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EntityUserOperatorSpec) DeepCopyInto(out *EntityUserOperatorSpec) {
+	*out = *in
+	if in.WatchedNamespace != nil {
+		in, out := &in.WatchedNamespace, &out.WatchedNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReconciliationIntervalMs != nil {
+		in, out := &in.ReconciliationIntervalMs, &out.ReconciliationIntervalMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SecretPrefix != nil {
+		in, out := &in.SecretPrefix, &out.SecretPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EntityUserOperatorSpec.
+func (in *EntityUserOperatorSpec) DeepCopy() *EntityUserOperatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EntityUserOperatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalConfiguration) DeepCopyInto(out *ExternalConfiguration) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]ExternalConfigurationEnv, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]ExternalConfigurationVolumeSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalConfiguration.
+func (in *ExternalConfiguration) DeepCopy() *ExternalConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalConfigurationEnv) DeepCopyInto(out *ExternalConfigurationEnv) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ExternalConfigurationEnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalConfigurationEnv.
+func (in *ExternalConfigurationEnv) DeepCopy() *ExternalConfigurationEnv {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalConfigurationEnv)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalConfigurationEnvVarSource) DeepCopyInto(out *ExternalConfigurationEnvVarSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalConfigurationEnvVarSource.
+func (in *ExternalConfigurationEnvVarSource) DeepCopy() *ExternalConfigurationEnvVarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalConfigurationEnvVarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalConfigurationReference) DeepCopyInto(out *ExternalConfigurationReference) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalConfigurationReference.
+func (in *ExternalConfigurationReference) DeepCopy() *ExternalConfigurationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalConfigurationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalConfigurationVolumeSource) DeepCopyInto(out *ExternalConfigurationVolumeSource) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalConfigurationVolumeSource.
+func (in *ExternalConfigurationVolumeSource) DeepCopy() *ExternalConfigurationVolumeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalConfigurationVolumeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericKafkaListener) DeepCopyInto(out *GenericKafkaListener) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicyPeers != nil {
+		in, out := &in.NetworkPolicyPeers, &out.NetworkPolicyPeers
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericKafkaListener.
+func (in *GenericKafkaListener) DeepCopy() *GenericKafkaListener {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericKafkaListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Int32Change) DeepCopyInto(out *Int32Change) {
+	*out = *in
+	if in.Old != nil {
+		in, out := &in.Old, &out.Old
+		*out = new(int32)
+		**out = **in
+	}
+	if in.New != nil {
+		in, out := &in.New, &out.New
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Int32Change.
+func (in *Int32Change) DeepCopy() *Int32Change {
+	if in == nil {
+		return nil
+	}
+	out := new(Int32Change)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JvmOptions) DeepCopyInto(out *JvmOptions) {
+	*out = *in
+	if in.XX != nil {
+		in, out := &in.XX, &out.XX
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Xms != nil {
+		in, out := &in.Xms, &out.Xms
+		*out = new(string)
+		**out = **in
+	}
+	if in.Xmx != nil {
+		in, out := &in.Xmx, &out.Xmx
+		*out = new(string)
+		**out = **in
+	}
+	if in.GcLoggingEnabled != nil {
+		in, out := &in.GcLoggingEnabled, &out.GcLoggingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JavaSystemProperties != nil {
+		in, out := &in.JavaSystemProperties, &out.JavaSystemProperties
+		*out = make([]SystemProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JvmOptions.
+func (in *JvmOptions) DeepCopy() *JvmOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(JvmOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kafka) DeepCopyInto(out *Kafka) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kafka) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaAutoRebalanceConfiguration) DeepCopyInto(out *KafkaAutoRebalanceConfiguration) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaAutoRebalanceConfiguration.
+func (in *KafkaAutoRebalanceConfiguration) DeepCopy() *KafkaAutoRebalanceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaAutoRebalanceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridge) DeepCopyInto(out *KafkaBridge) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaBridgeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaBridgeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridge.
+func (in *KafkaBridge) DeepCopy() *KafkaBridge {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaBridge) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeAdminClientSpec) DeepCopyInto(out *KafkaBridgeAdminClientSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeAdminClientSpec.
+func (in *KafkaBridgeAdminClientSpec) DeepCopy() *KafkaBridgeAdminClientSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeAdminClientSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeConsumerSpec) DeepCopyInto(out *KafkaBridgeConsumerSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeConsumerSpec.
+func (in *KafkaBridgeConsumerSpec) DeepCopy() *KafkaBridgeConsumerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeConsumerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeHTTPConfig) DeepCopyInto(out *KafkaBridgeHTTPConfig) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Cors != nil {
+		in, out := &in.Cors, &out.Cors
+		*out = new(KafkaBridgeHTTPCors)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeHTTPConfig.
+func (in *KafkaBridgeHTTPConfig) DeepCopy() *KafkaBridgeHTTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeHTTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeHTTPCors) DeepCopyInto(out *KafkaBridgeHTTPCors) {
+	*out = *in
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeHTTPCors.
+func (in *KafkaBridgeHTTPCors) DeepCopy() *KafkaBridgeHTTPCors {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeHTTPCors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeList) DeepCopyInto(out *KafkaBridgeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaBridge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeList.
+func (in *KafkaBridgeList) DeepCopy() *KafkaBridgeList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaBridgeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeProducerSpec) DeepCopyInto(out *KafkaBridgeProducerSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeProducerSpec.
+func (in *KafkaBridgeProducerSpec) DeepCopy() *KafkaBridgeProducerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeProducerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeSpec) DeepCopyInto(out *KafkaBridgeSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ClientTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(KafkaBridgeHTTPConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminClient != nil {
+		in, out := &in.AdminClient, &out.AdminClient
+		*out = new(KafkaBridgeAdminClientSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consumer != nil {
+		in, out := &in.Consumer, &out.Consumer
+		*out = new(KafkaBridgeConsumerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Producer != nil {
+		in, out := &in.Producer, &out.Producer
+		*out = new(KafkaBridgeProducerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientRackInitImage != nil {
+		in, out := &in.ClientRackInitImage, &out.ClientRackInitImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rack != nil {
+		in, out := &in.Rack, &out.Rack
+		*out = new(Rack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableMetrics != nil {
+		in, out := &in.EnableMetrics, &out.EnableMetrics
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeSpec.
+func (in *KafkaBridgeSpec) DeepCopy() *KafkaBridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBridgeStatus) DeepCopyInto(out *KafkaBridgeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridgeStatus.
+func (in *KafkaBridgeStatus) DeepCopy() *KafkaBridgeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridgeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaBridges) DeepCopyInto(out *KafkaBridges) {
+	{
+		in := &in
+		*out = make(KafkaBridges, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBridges.
+func (in KafkaBridges) DeepCopy() KafkaBridges {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBridges)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaClusterSpec) DeepCopyInto(out *KafkaClusterSpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetadataVersion != nil {
+		in, out := &in.MetadataVersion, &out.MetadataVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]GenericKafkaListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rack != nil {
+		in, out := &in.Rack, &out.Rack
+		*out = new(Rack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TieredStorage != nil {
+		in, out := &in.TieredStorage, &out.TieredStorage
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaClusterSpec.
+func (in *KafkaClusterSpec) DeepCopy() *KafkaClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaConnectSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaConnectStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
+func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnect, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
+func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ClientTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JmxOptions != nil {
+		in, out := &in.JmxOptions, &out.JmxOptions
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientRackInitImage != nil {
+		in, out := &in.ClientRackInitImage, &out.ClientRackInitImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rack != nil {
+		in, out := &in.Rack, &out.Rack
+		*out = new(Rack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalConfiguration != nil {
+		in, out := &in.ExternalConfiguration, &out.ExternalConfiguration
+		*out = new(ExternalConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Build != nil {
+		in, out := &in.Build, &out.Build
+		*out = new(Build)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
+func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectStatus) DeepCopyInto(out *KafkaConnectStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectorPlugins != nil {
+		in, out := &in.ConnectorPlugins, &out.ConnectorPlugins
+		*out = make([]ConnectorPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectStatus.
+func (in *KafkaConnectStatus) DeepCopy() *KafkaConnectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaConnectorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
+func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
+func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+	*out = *in
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(string)
+		**out = **in
+	}
+	if in.TasksMax != nil {
+		in, out := &in.TasksMax, &out.TasksMax
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRestart != nil {
+		in, out := &in.AutoRestart, &out.AutoRestart
+		*out = new(AutoRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListOffsets != nil {
+		in, out := &in.ListOffsets, &out.ListOffsets
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlterOffsets != nil {
+		in, out := &in.AlterOffsets, &out.AlterOffsets
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
+func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoRestart != nil {
+		in, out := &in.AutoRestart, &out.AutoRestart
+		*out = new(AutoRestartStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectorStatus != nil {
+		in, out := &in.ConnectorStatus, &out.ConnectorStatus
+		*out = new(ConnectorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TasksMax != nil {
+		in, out := &in.TasksMax, &out.TasksMax
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
+func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaConnectors) DeepCopyInto(out *KafkaConnectors) {
+	{
+		in := &in
+		*out = make(KafkaConnectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectors.
+func (in KafkaConnectors) DeepCopy() KafkaConnectors {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectors)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaConnects) DeepCopyInto(out *KafkaConnects) {
+	{
+		in := &in
+		*out = make(KafkaConnects, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnects.
+func (in KafkaConnects) DeepCopy() KafkaConnects {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnects)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Kafka, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
+func (in *KafkaList) DeepCopy() *KafkaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2) DeepCopyInto(out *KafkaMirrorMaker2) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaMirrorMaker2Spec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaMirrorMaker2Status)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2.
+func (in *KafkaMirrorMaker2) DeepCopy() *KafkaMirrorMaker2 {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMaker2) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2ClusterSpec) DeepCopyInto(out *KafkaMirrorMaker2ClusterSpec) {
+	*out = *in
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = new(string)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ClientTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2ClusterSpec.
+func (in *KafkaMirrorMaker2ClusterSpec) DeepCopy() *KafkaMirrorMaker2ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2ConnectorSpec) DeepCopyInto(out *KafkaMirrorMaker2ConnectorSpec) {
+	*out = *in
+	if in.TasksMax != nil {
+		in, out := &in.TasksMax, &out.TasksMax
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRestart != nil {
+		in, out := &in.AutoRestart, &out.AutoRestart
+		*out = new(AutoRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListOffsets != nil {
+		in, out := &in.ListOffsets, &out.ListOffsets
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlterOffsets != nil {
+		in, out := &in.AlterOffsets, &out.AlterOffsets
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2ConnectorSpec.
+func (in *KafkaMirrorMaker2ConnectorSpec) DeepCopy() *KafkaMirrorMaker2ConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2ConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2List) DeepCopyInto(out *KafkaMirrorMaker2List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaMirrorMaker2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2List.
+func (in *KafkaMirrorMaker2List) DeepCopy() *KafkaMirrorMaker2List {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMaker2List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2MirrorSpec) DeepCopyInto(out *KafkaMirrorMaker2MirrorSpec) {
+	*out = *in
+	if in.SourceCluster != nil {
+		in, out := &in.SourceCluster, &out.SourceCluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetCluster != nil {
+		in, out := &in.TargetCluster, &out.TargetCluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceConnector != nil {
+		in, out := &in.SourceConnector, &out.SourceConnector
+		*out = new(KafkaMirrorMaker2ConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeartbeatConnector != nil {
+		in, out := &in.HeartbeatConnector, &out.HeartbeatConnector
+		*out = new(KafkaMirrorMaker2ConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CheckpointConnector != nil {
+		in, out := &in.CheckpointConnector, &out.CheckpointConnector
+		*out = new(KafkaMirrorMaker2ConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopicsPattern != nil {
+		in, out := &in.TopicsPattern, &out.TopicsPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicsExcludePattern != nil {
+		in, out := &in.TopicsExcludePattern, &out.TopicsExcludePattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupsPattern != nil {
+		in, out := &in.GroupsPattern, &out.GroupsPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupsExcludePattern != nil {
+		in, out := &in.GroupsExcludePattern, &out.GroupsExcludePattern
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2MirrorSpec.
+func (in *KafkaMirrorMaker2MirrorSpec) DeepCopy() *KafkaMirrorMaker2MirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2MirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2Spec) DeepCopyInto(out *KafkaMirrorMaker2Spec) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectCluster != nil {
+		in, out := &in.ConnectCluster, &out.ConnectCluster
+		*out = new(string)
+		**out = **in
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]KafkaMirrorMaker2ClusterSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]KafkaMirrorMaker2MirrorSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JmxOptions != nil {
+		in, out := &in.JmxOptions, &out.JmxOptions
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientRackInitImage != nil {
+		in, out := &in.ClientRackInitImage, &out.ClientRackInitImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rack != nil {
+		in, out := &in.Rack, &out.Rack
+		*out = new(Rack)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalConfiguration != nil {
+		in, out := &in.ExternalConfiguration, &out.ExternalConfiguration
+		*out = new(ExternalConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2Spec.
+func (in *KafkaMirrorMaker2Spec) DeepCopy() *KafkaMirrorMaker2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker2Status) DeepCopyInto(out *KafkaMirrorMaker2Status) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Connectors != nil {
+		in, out := &in.Connectors, &out.Connectors
+		*out = make([]ConnectorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoRestartStatuses != nil {
+		in, out := &in.AutoRestartStatuses, &out.AutoRestartStatuses
+		*out = make([]AutoRestartStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConnectorPlugins != nil {
+		in, out := &in.ConnectorPlugins, &out.ConnectorPlugins
+		*out = make([]ConnectorPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2Status.
+func (in *KafkaMirrorMaker2Status) DeepCopy() *KafkaMirrorMaker2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaMirrorMaker2s) DeepCopyInto(out *KafkaMirrorMaker2s) {
+	{
+		in := &in
+		*out = make(KafkaMirrorMaker2s, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker2s.
+func (in KafkaMirrorMaker2s) DeepCopy() KafkaMirrorMaker2s {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker2s)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNodePool) DeepCopyInto(out *KafkaNodePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaNodePoolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaNodePoolStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNodePool.
+func (in *KafkaNodePool) DeepCopy() *KafkaNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNodePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNodePoolList) DeepCopyInto(out *KafkaNodePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNodePoolList.
+func (in *KafkaNodePoolList) DeepCopy() *KafkaNodePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNodePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaNodePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNodePoolSpec) DeepCopyInto(out *KafkaNodePoolSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNodePoolSpec.
+func (in *KafkaNodePoolSpec) DeepCopy() *KafkaNodePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNodePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaNodePoolStatus) DeepCopyInto(out *KafkaNodePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeIDs != nil {
+		in, out := &in.NodeIDs, &out.NodeIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNodePoolStatus.
+func (in *KafkaNodePoolStatus) DeepCopy() *KafkaNodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaNodePools) DeepCopyInto(out *KafkaNodePools) {
+	{
+		in := &in
+		*out = make(KafkaNodePools, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaNodePools.
+func (in KafkaNodePools) DeepCopy() KafkaNodePools {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaNodePools)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaRebalance) DeepCopyInto(out *KafkaRebalance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaRebalanceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaRebalanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRebalance.
+func (in *KafkaRebalance) DeepCopy() *KafkaRebalance {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRebalance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaRebalance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaRebalanceList) DeepCopyInto(out *KafkaRebalanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaRebalance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRebalanceList.
+func (in *KafkaRebalanceList) DeepCopy() *KafkaRebalanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRebalanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaRebalanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaRebalanceSpec) DeepCopyInto(out *KafkaRebalanceSpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Goals != nil {
+		in, out := &in.Goals, &out.Goals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkipHardGoalCheck != nil {
+		in, out := &in.SkipHardGoalCheck, &out.SkipHardGoalCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RebalanceDisk != nil {
+		in, out := &in.RebalanceDisk, &out.RebalanceDisk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludedTopics != nil {
+		in, out := &in.ExcludedTopics, &out.ExcludedTopics
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConcurrentPartitionMovementsPerBroker != nil {
+		in, out := &in.ConcurrentPartitionMovementsPerBroker, &out.ConcurrentPartitionMovementsPerBroker
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConcurrentIntraBrokerPartitionMovements != nil {
+		in, out := &in.ConcurrentIntraBrokerPartitionMovements, &out.ConcurrentIntraBrokerPartitionMovements
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConcurrentLeaderMovements != nil {
+		in, out := &in.ConcurrentLeaderMovements, &out.ConcurrentLeaderMovements
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicationThrottle != nil {
+		in, out := &in.ReplicationThrottle, &out.ReplicationThrottle
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReplicaMovementStrategies != nil {
+		in, out := &in.ReplicaMovementStrategies, &out.ReplicaMovementStrategies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MoveReplicasOffVolumes != nil {
+		in, out := &in.MoveReplicasOffVolumes, &out.MoveReplicasOffVolumes
+		*out = make([]BrokerAndVolumeIDs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRebalanceSpec.
+func (in *KafkaRebalanceSpec) DeepCopy() *KafkaRebalanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRebalanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaRebalanceStatus) DeepCopyInto(out *KafkaRebalanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SessionID != nil {
+		in, out := &in.SessionID, &out.SessionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OptimizationResult != nil {
+		in, out := &in.OptimizationResult, &out.OptimizationResult
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRebalanceStatus.
+func (in *KafkaRebalanceStatus) DeepCopy() *KafkaRebalanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRebalanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaRebalances) DeepCopyInto(out *KafkaRebalances) {
+	{
+		in := &in
+		*out = make(KafkaRebalances, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaRebalances.
+func (in KafkaRebalances) DeepCopy() KafkaRebalances {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaRebalances)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zookeeper != nil {
+		in, out := &in.Zookeeper, &out.Zookeeper
+		*out = new(ZookeeperClusterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EntityOperator != nil {
+		in, out := &in.EntityOperator, &out.EntityOperator
+		*out = new(EntityOperatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CruiseControl != nil {
+		in, out := &in.CruiseControl, &out.CruiseControl
+		*out = new(CruiseControlSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterCa != nil {
+		in, out := &in.ClusterCa, &out.ClusterCa
+		*out = new(CertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientsCa != nil {
+		in, out := &in.ClientsCa, &out.ClientsCa
+		*out = new(CertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KafkaExporter != nil {
+		in, out := &in.KafkaExporter, &out.KafkaExporter
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceTimeWindows != nil {
+		in, out := &in.MaintenanceTimeWindows, &out.MaintenanceTimeWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaStatus) DeepCopyInto(out *KafkaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KafkaNodePools != nil {
+		in, out := &in.KafkaNodePools, &out.KafkaNodePools
+		*out = make([]UsedNodePoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RegisteredNodeIDs != nil {
+		in, out := &in.RegisteredNodeIDs, &out.RegisteredNodeIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterID != nil {
+		in, out := &in.ClusterID, &out.ClusterID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatorLastSuccessfulVersion != nil {
+		in, out := &in.OperatorLastSuccessfulVersion, &out.OperatorLastSuccessfulVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KafkaVersion != nil {
+		in, out := &in.KafkaVersion, &out.KafkaVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KafkaMetadataVersion != nil {
+		in, out := &in.KafkaMetadataVersion, &out.KafkaMetadataVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.KafkaMetadataState != nil {
+		in, out := &in.KafkaMetadataState, &out.KafkaMetadataState
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoRebalance != nil {
+		in, out := &in.AutoRebalance, &out.AutoRebalance
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaStatus.
+func (in *KafkaStatus) DeepCopy() *KafkaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaTopicSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaTopicStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
+func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicDiff) DeepCopyInto(out *KafkaTopicDiff) {
+	*out = *in
+	if in.TopicName != nil {
+		in, out := &in.TopicName, &out.TopicName
+		*out = new(StringChange)
+		**out = **in
+	}
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(Int32Change)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(Int32Change)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make([]KeyChange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicDiff.
+func (in *KafkaTopicDiff) DeepCopy() *KafkaTopicDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicList) DeepCopyInto(out *KafkaTopicList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicList.
+func (in *KafkaTopicList) DeepCopy() *KafkaTopicList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTopicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicSpec) DeepCopyInto(out *KafkaTopicSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TopicName != nil {
+		in, out := &in.TopicName, &out.TopicName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpec.
+func (in *KafkaTopicSpec) DeepCopy() *KafkaTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaTopicSpecs) DeepCopyInto(out *KafkaTopicSpecs) {
+	{
+		in := &in
+		*out = make(KafkaTopicSpecs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicSpecs.
+func (in KafkaTopicSpecs) DeepCopy() KafkaTopicSpecs {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicSpecs)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicStatus) DeepCopyInto(out *KafkaTopicStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]KafkaTopicStatusConditionsElem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TopicName != nil {
+		in, out := &in.TopicName, &out.TopicName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatus.
+func (in *KafkaTopicStatus) DeepCopy() *KafkaTopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopicStatusConditionsElem) DeepCopyInto(out *KafkaTopicStatusConditionsElem) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopicStatusConditionsElem.
+func (in *KafkaTopicStatusConditionsElem) DeepCopy() *KafkaTopicStatusConditionsElem {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopicStatusConditionsElem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaTopics) DeepCopyInto(out *KafkaTopics) {
+	{
+		in := &in
+		*out = make(KafkaTopics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopics.
+func (in KafkaTopics) DeepCopy() KafkaTopics {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopics)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUser) DeepCopyInto(out *KafkaUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(KafkaUserSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(KafkaUserStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUser.
+func (in *KafkaUser) DeepCopy() *KafkaUser {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserAuthentication) DeepCopyInto(out *KafkaUserAuthentication) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(Password)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserAuthentication.
+func (in *KafkaUserAuthentication) DeepCopy() *KafkaUserAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserAuthorization) DeepCopyInto(out *KafkaUserAuthorization) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Acls != nil {
+		in, out := &in.Acls, &out.Acls
+		*out = make([]AclRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserAuthorization.
+func (in *KafkaUserAuthorization) DeepCopy() *KafkaUserAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserList) DeepCopyInto(out *KafkaUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserList.
+func (in *KafkaUserList) DeepCopy() *KafkaUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserQuotas) DeepCopyInto(out *KafkaUserQuotas) {
+	*out = *in
+	if in.ProducerByteRate != nil {
+		in, out := &in.ProducerByteRate, &out.ProducerByteRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConsumerByteRate != nil {
+		in, out := &in.ConsumerByteRate, &out.ConsumerByteRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequestPercentage != nil {
+		in, out := &in.RequestPercentage, &out.RequestPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ControllerMutationRate != nil {
+		in, out := &in.ControllerMutationRate, &out.ControllerMutationRate
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserQuotas.
+func (in *KafkaUserQuotas) DeepCopy() *KafkaUserQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserSpec) DeepCopyInto(out *KafkaUserSpec) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaUserAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authorization != nil {
+		in, out := &in.Authorization, &out.Authorization
+		*out = new(KafkaUserAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(KafkaUserQuotas)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserSpec.
+func (in *KafkaUserSpec) DeepCopy() *KafkaUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaUserStatus) DeepCopyInto(out *KafkaUserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUserStatus.
+func (in *KafkaUserStatus) DeepCopy() *KafkaUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in KafkaUsers) DeepCopyInto(out *KafkaUsers) {
 	{
 		in := &in
-		*out = make(KafkaTopics, len(*in))
+		*out = make(KafkaUsers, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaUsers.
+func (in KafkaUsers) DeepCopy() KafkaUsers {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaUsers)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Kafkas) DeepCopyInto(out *Kafkas) {
+	{
+		in := &in
+		*out = make(Kafkas, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafkas.
+func (in Kafkas) DeepCopy() Kafkas {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafkas)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyChange) DeepCopyInto(out *KeyChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyChange.
+func (in *KeyChange) DeepCopy() *KeyChange {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerAddress) DeepCopyInto(out *ListenerAddress) {
+	*out = *in
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerAddress.
+func (in *ListenerAddress) DeepCopy() *ListenerAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]ListenerAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = new(string)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerStatus.
+func (in *ListenerStatus) DeepCopy() *ListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Loggers != nil {
+		in, out := &in.Loggers, &out.Loggers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ExternalConfigurationReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfig) DeepCopyInto(out *MetricsConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ExternalConfigurationReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfig.
+func (in *MetricsConfig) DeepCopy() *MetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Password) DeepCopyInto(out *Password) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(PasswordSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Password.
+func (in *Password) DeepCopy() *Password {
+	if in == nil {
+		return nil
+	}
+	out := new(Password)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordSource) DeepCopyInto(out *PasswordSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSource.
+func (in *PasswordSource) DeepCopy() *PasswordSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Probe) DeepCopyInto(out *Probe) {
+	*out = *in
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessThreshold != nil {
+		in, out := &in.SuccessThreshold, &out.SuccessThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Probe.
+func (in *Probe) DeepCopy() *Probe {
+	if in == nil {
+		return nil
+	}
+	out := new(Probe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rack) DeepCopyInto(out *Rack) {
+	*out = *in
+	if in.TopologyKey != nil {
+		in, out := &in.TopologyKey, &out.TopologyKey
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rack.
+func (in *Rack) DeepCopy() *Rack {
+	if in == nil {
+		return nil
+	}
+	out := new(Rack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Storage) DeepCopyInto(out *Storage) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(string)
+		**out = **in
+	}
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		*out = new(string)
+		**out = **in
+	}
+	if in.Class != nil {
+		in, out := &in.Class, &out.Class
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteClaim != nil {
+		in, out := &in.DeleteClaim, &out.DeleteClaim
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KraftMetadata != nil {
+		in, out := &in.KraftMetadata, &out.KraftMetadata
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]Storage, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
-		return
 	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopics.
-func (in KafkaTopics) DeepCopy() KafkaTopics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
+func (in *Storage) DeepCopy() *Storage {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopics)
+	out := new(Storage)
 	in.DeepCopyInto(out)
-	return *out
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StringChange) DeepCopyInto(out *StringChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StringChange.
+func (in *StringChange) DeepCopy() *StringChange {
+	if in == nil {
+		return nil
+	}
+	out := new(StringChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemProperty) DeepCopyInto(out *SystemProperty) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemProperty.
+func (in *SystemProperty) DeepCopy() *SystemProperty {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemProperty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicConfig) DeepCopyInto(out *TopicConfig) {
+	*out = *in
+	if in.CleanupPolicy != nil {
+		in, out := &in.CleanupPolicy, &out.CleanupPolicy
+		*out = make([]CleanupPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompressionType != nil {
+		in, out := &in.CompressionType, &out.CompressionType
+		*out = new(CompressionType)
+		**out = **in
+	}
+	if in.CompressionGzipLevel != nil {
+		in, out := &in.CompressionGzipLevel, &out.CompressionGzipLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CompressionLz4Level != nil {
+		in, out := &in.CompressionLz4Level, &out.CompressionLz4Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CompressionZstdLevel != nil {
+		in, out := &in.CompressionZstdLevel, &out.CompressionZstdLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeleteRetentionMs != nil {
+		in, out := &in.DeleteRetentionMs, &out.DeleteRetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FileDeleteDelayMs != nil {
+		in, out := &in.FileDeleteDelayMs, &out.FileDeleteDelayMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushMessages != nil {
+		in, out := &in.FlushMessages, &out.FlushMessages
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushMs != nil {
+		in, out := &in.FlushMs, &out.FlushMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FollowerReplicationThrottled != nil {
+		in, out := &in.FollowerReplicationThrottled, &out.FollowerReplicationThrottled
+		*out = new(string)
+		**out = **in
+	}
+	if in.IndexIntervalBytes != nil {
+		in, out := &in.IndexIntervalBytes, &out.IndexIntervalBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeaderReplicationThrottled != nil {
+		in, out := &in.LeaderReplicationThrottled, &out.LeaderReplicationThrottled
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalRetentionBytes != nil {
+		in, out := &in.LocalRetentionBytes, &out.LocalRetentionBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LocalRetentionMs != nil {
+		in, out := &in.LocalRetentionMs, &out.LocalRetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCompactionLagMs != nil {
+		in, out := &in.MaxCompactionLagMs, &out.MaxCompactionLagMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxMessageBytes != nil {
+		in, out := &in.MaxMessageBytes, &out.MaxMessageBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MessageDownconversionEnable != nil {
+		in, out := &in.MessageDownconversionEnable, &out.MessageDownconversionEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MessageFormatVersion != nil {
+		in, out := &in.MessageFormatVersion, &out.MessageFormatVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageTimestampAfterMaxMs != nil {
+		in, out := &in.MessageTimestampAfterMaxMs, &out.MessageTimestampAfterMaxMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MessageTimestampBeforeMaxMs != nil {
+		in, out := &in.MessageTimestampBeforeMaxMs, &out.MessageTimestampBeforeMaxMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MessageTimestampDifferenceMaxMs != nil {
+		in, out := &in.MessageTimestampDifferenceMaxMs, &out.MessageTimestampDifferenceMaxMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MessageTimestampType != nil {
+		in, out := &in.MessageTimestampType, &out.MessageTimestampType
+		*out = new(MessageTimestampType)
+		**out = **in
+	}
+	if in.MinCleanableDirtyRatio != nil {
+		in, out := &in.MinCleanableDirtyRatio, &out.MinCleanableDirtyRatio
+		*out = new(float64)
+		**out = **in
+	}
+	if in.MinCompactionLagMs != nil {
+		in, out := &in.MinCompactionLagMs, &out.MinCompactionLagMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinInsyncReplicas != nil {
+		in, out := &in.MinInsyncReplicas, &out.MinInsyncReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Preallocate != nil {
+		in, out := &in.Preallocate, &out.Preallocate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteLogCopyDisable != nil {
+		in, out := &in.RemoteLogCopyDisable, &out.RemoteLogCopyDisable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteLogDeleteOnDisable != nil {
+		in, out := &in.RemoteLogDeleteOnDisable, &out.RemoteLogDeleteOnDisable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RemoteStorageEnable != nil {
+		in, out := &in.RemoteStorageEnable, &out.RemoteStorageEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetentionBytes != nil {
+		in, out := &in.RetentionBytes, &out.RetentionBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetentionMs != nil {
+		in, out := &in.RetentionMs, &out.RetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentBytes != nil {
+		in, out := &in.SegmentBytes, &out.SegmentBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SegmentIndexBytes != nil {
+		in, out := &in.SegmentIndexBytes, &out.SegmentIndexBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SegmentJitterMs != nil {
+		in, out := &in.SegmentJitterMs, &out.SegmentJitterMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SegmentMs != nil {
+		in, out := &in.SegmentMs, &out.SegmentMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UncleanLeaderElectionEnable != nil {
+		in, out := &in.UncleanLeaderElectionEnable, &out.UncleanLeaderElectionEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Other != nil {
+		in, out := &in.Other, &out.Other
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicConfig.
+func (in *TopicConfig) DeepCopy() *TopicConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsedNodePoolStatus) DeepCopyInto(out *UsedNodePoolStatus) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsedNodePoolStatus.
+func (in *UsedNodePoolStatus) DeepCopy() *UsedNodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UsedNodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZookeeperClusterSpec) DeepCopyInto(out *ZookeeperClusterSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JvmOptions != nil {
+		in, out := &in.JvmOptions, &out.JvmOptions
+		*out = new(JvmOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsConfig != nil {
+		in, out := &in.MetricsConfig, &out.MetricsConfig
+		*out = new(MetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZookeeperClusterSpec.
+func (in *ZookeeperClusterSpec) DeepCopy() *ZookeeperClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZookeeperClusterSpec)
+	in.DeepCopyInto(out)
+	return out
 }
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ConditionApplyConfiguration represents a declarative configuration of the Condition type for use
+// with apply.
+//
+// Condition describes the state of a Strimzi custom resource at a certain point.
+type ConditionApplyConfiguration struct {
+	// Last time the condition of a type changed from one status to another. The
+	// required format is 'yyyy-MM-ddTHH:mm:ssZ', in the UTC time zone.
+	LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+	// Human-readable message indicating details about the condition's last
+	// transition.
+	Message *string `json:"message,omitempty"`
+	// The reason for the condition's last transition (a single word in CamelCase).
+	Reason *string `json:"reason,omitempty"`
+	// The status of the condition, either True, False or Unknown.
+	Status *string `json:"status,omitempty"`
+	// The unique identifier of a condition, used to distinguish between other
+	// conditions in the resource.
+	Type *string `json:"type,omitempty"`
+}
+
+// ConditionApplyConfiguration constructs a declarative configuration of the Condition type for use with
+// apply.
+func Condition() *ConditionApplyConfiguration {
+	return &ConditionApplyConfiguration{}
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithLastTransitionTime(value string) *ConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithMessage(value string) *ConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithReason(value string) *ConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithStatus(value string) *ConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ConditionApplyConfiguration) WithType(value string) *ConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
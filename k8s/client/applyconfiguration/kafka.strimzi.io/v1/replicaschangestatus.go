@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ReplicasChangeStatusApplyConfiguration represents a declarative configuration of the ReplicasChangeStatus type for use
+// with apply.
+type ReplicasChangeStatusApplyConfiguration struct {
+	// Current state of the replicas change operation. This can be `pending`,
+	// when the change has been requested, or `ongoing`, when the change has been
+	// successfully submitted to Cruise Control.
+	State *string `json:"state,omitempty"`
+	// The target replicas value requested by the user. This may be different
+	// from .spec.replicas when a change is ongoing.
+	TargetReplicas *int32 `json:"targetReplicas,omitempty"`
+	// Message for the user related to the replicas change request. This may
+	// contain transient error messages that would disappear on periodic
+	// reconciliations.
+	Message *string `json:"message,omitempty"`
+	// The session identifier for replicas change requests pertaining to this
+	// KafkaTopic resource. This is used by the Topic Operator to track the
+	// status of ongoing replicas change operations.
+	SessionID *string `json:"sessionId,omitempty"`
+}
+
+// ReplicasChangeStatusApplyConfiguration constructs a declarative configuration of the ReplicasChangeStatus type for use with
+// apply.
+func ReplicasChangeStatus() *ReplicasChangeStatusApplyConfiguration {
+	return &ReplicasChangeStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ReplicasChangeStatusApplyConfiguration) WithState(value string) *ReplicasChangeStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithTargetReplicas sets the TargetReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetReplicas field is set to the value of the last call.
+func (b *ReplicasChangeStatusApplyConfiguration) WithTargetReplicas(value int32) *ReplicasChangeStatusApplyConfiguration {
+	b.TargetReplicas = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *ReplicasChangeStatusApplyConfiguration) WithMessage(value string) *ReplicasChangeStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithSessionID sets the SessionID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionID field is set to the value of the last call.
+func (b *ReplicasChangeStatusApplyConfiguration) WithSessionID(value string) *ReplicasChangeStatusApplyConfiguration {
+	b.SessionID = &value
+	return b
+}
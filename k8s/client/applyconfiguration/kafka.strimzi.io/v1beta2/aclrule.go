@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AclRuleApplyConfiguration represents a declarative configuration of the AclRule type for use
+// with apply.
+type AclRuleApplyConfiguration struct {
+	// Indicates the resource for which given ACL rule applies.
+	Resource *AclRuleResourceApplyConfiguration `json:"resource,omitempty"`
+	// The type of the rule. ACL rules with type `allow` are used to allow user to
+	// execute the specified operations. ACL rules with type `deny` are used to
+	// deny user to execute the specified operations. Default value is `allow`.
+	Type *string `json:"type,omitempty"`
+	// List of operations to allow or deny. Supported operations are: Read,
+	// Write, Create, Delete, Alter, Describe, ClusterAction, AlterConfigs,
+	// DescribeConfigs, IdempotentWrite and All. Only certain operations work
+	// with the specified resource.
+	Operations []string `json:"operations,omitempty"`
+	// The host from which the action described in the ACL rule is allowed or
+	// denied. If not set, it defaults to `*`, allowing or denying the action from
+	// any host.
+	Host *string `json:"host,omitempty"`
+}
+
+// AclRuleApplyConfiguration constructs a declarative configuration of the AclRule type for use with
+// apply.
+func AclRule() *AclRuleApplyConfiguration {
+	return &AclRuleApplyConfiguration{}
+}
+
+// WithResource sets the Resource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resource field is set to the value of the last call.
+func (b *AclRuleApplyConfiguration) WithResource(value *AclRuleResourceApplyConfiguration) *AclRuleApplyConfiguration {
+	b.Resource = value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AclRuleApplyConfiguration) WithType(value string) *AclRuleApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithOperations adds the given value to the Operations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Operations field.
+func (b *AclRuleApplyConfiguration) WithOperations(values ...string) *AclRuleApplyConfiguration {
+	for i := range values {
+		b.Operations = append(b.Operations, values[i])
+	}
+	return b
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *AclRuleApplyConfiguration) WithHost(value string) *AclRuleApplyConfiguration {
+	b.Host = &value
+	return b
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AclRuleResourceApplyConfiguration represents a declarative configuration of the AclRuleResource type for use
+// with apply.
+type AclRuleResourceApplyConfiguration struct {
+	// Resource type. The available resource types are `topic`, `group`,
+	// `cluster`, and `transactionalId`.
+	Type *string `json:"type,omitempty"`
+	// Name of resource for which given ACL rule applies. Can be combined with
+	// `patternType` field to use prefix pattern. Not used for type `cluster`.
+	Name *string `json:"name,omitempty"`
+	// Describes the pattern used in the resource field. The supported types are
+	// `literal` and `prefix`. With `literal` pattern type, the resource field
+	// will be used as a definition of a full name. With `prefix` pattern type,
+	// the resource name will be used only as a prefix. Default value is
+	// `literal`.
+	PatternType *string `json:"patternType,omitempty"`
+}
+
+// AclRuleResourceApplyConfiguration constructs a declarative configuration of the AclRuleResource type for use with
+// apply.
+func AclRuleResource() *AclRuleResourceApplyConfiguration {
+	return &AclRuleResourceApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *AclRuleResourceApplyConfiguration) WithType(value string) *AclRuleResourceApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AclRuleResourceApplyConfiguration) WithName(value string) *AclRuleResourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPatternType sets the PatternType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PatternType field is set to the value of the last call.
+func (b *AclRuleResourceApplyConfiguration) WithPatternType(value string) *AclRuleResourceApplyConfiguration {
+	b.PatternType = &value
+	return b
+}
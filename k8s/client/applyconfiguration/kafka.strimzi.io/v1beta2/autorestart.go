@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AutoRestartApplyConfiguration represents a declarative configuration of the AutoRestart type for use
+// with apply.
+type AutoRestartApplyConfiguration struct {
+	// Whether automatic restart for failed connectors and tasks should be enabled
+	// or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The maximum number of connector restarts that the operator will try. If
+	// the connector remains in a failed state after reaching this limit, it must
+	// be restarted manually by the user. Defaults to an unlimited number of
+	// restarts.
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+}
+
+// AutoRestartApplyConfiguration constructs a declarative configuration of the AutoRestart type for use with
+// apply.
+func AutoRestart() *AutoRestartApplyConfiguration {
+	return &AutoRestartApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *AutoRestartApplyConfiguration) WithEnabled(value bool) *AutoRestartApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithMaxRestarts sets the MaxRestarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRestarts field is set to the value of the last call.
+func (b *AutoRestartApplyConfiguration) WithMaxRestarts(value int32) *AutoRestartApplyConfiguration {
+	b.MaxRestarts = &value
+	return b
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AutoRestartStatusApplyConfiguration represents a declarative configuration of the AutoRestartStatus type for use
+// with apply.
+type AutoRestartStatusApplyConfiguration struct {
+	// The number of times the connector or task is restarted.
+	Count *int32 `json:"count,omitempty"`
+	// The name of the connector being restarted.
+	ConnectorName *string `json:"connectorName,omitempty"`
+	// The last time the automatic restart was attempted. The required format is
+	// 'yyyy-MM-ddTHH:mm:ssZ' in the UTC time zone.
+	LastRestartTimestamp *string `json:"lastRestartTimestamp,omitempty"`
+}
+
+// AutoRestartStatusApplyConfiguration constructs a declarative configuration of the AutoRestartStatus type for use with
+// apply.
+func AutoRestartStatus() *AutoRestartStatusApplyConfiguration {
+	return &AutoRestartStatusApplyConfiguration{}
+}
+
+// WithCount sets the Count field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Count field is set to the value of the last call.
+func (b *AutoRestartStatusApplyConfiguration) WithCount(value int32) *AutoRestartStatusApplyConfiguration {
+	b.Count = &value
+	return b
+}
+
+// WithConnectorName sets the ConnectorName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectorName field is set to the value of the last call.
+func (b *AutoRestartStatusApplyConfiguration) WithConnectorName(value string) *AutoRestartStatusApplyConfiguration {
+	b.ConnectorName = &value
+	return b
+}
+
+// WithLastRestartTimestamp sets the LastRestartTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRestartTimestamp field is set to the value of the last call.
+func (b *AutoRestartStatusApplyConfiguration) WithLastRestartTimestamp(value string) *AutoRestartStatusApplyConfiguration {
+	b.LastRestartTimestamp = &value
+	return b
+}
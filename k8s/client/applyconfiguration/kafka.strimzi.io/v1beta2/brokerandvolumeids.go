@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BrokerAndVolumeIDsApplyConfiguration represents a declarative configuration of the BrokerAndVolumeIDs type for use
+// with apply.
+type BrokerAndVolumeIDsApplyConfiguration struct {
+	// ID of the broker that contains the disk from which you want to move the
+	// partition replicas.
+	BrokerID *int32 `json:"brokerId,omitempty"`
+	// IDs of the disks from which the partition replicas need to be moved.
+	VolumeIDs []int32 `json:"volumeIds,omitempty"`
+}
+
+// BrokerAndVolumeIDsApplyConfiguration constructs a declarative configuration of the BrokerAndVolumeIDs type for use with
+// apply.
+func BrokerAndVolumeIDs() *BrokerAndVolumeIDsApplyConfiguration {
+	return &BrokerAndVolumeIDsApplyConfiguration{}
+}
+
+// WithBrokerID sets the BrokerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BrokerID field is set to the value of the last call.
+func (b *BrokerAndVolumeIDsApplyConfiguration) WithBrokerID(value int32) *BrokerAndVolumeIDsApplyConfiguration {
+	b.BrokerID = &value
+	return b
+}
+
+// WithVolumeIDs adds the given value to the VolumeIDs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VolumeIDs field.
+func (b *BrokerAndVolumeIDsApplyConfiguration) WithVolumeIDs(values ...int32) *BrokerAndVolumeIDsApplyConfiguration {
+	for i := range values {
+		b.VolumeIDs = append(b.VolumeIDs, values[i])
+	}
+	return b
+}
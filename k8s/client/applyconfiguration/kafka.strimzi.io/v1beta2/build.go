@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// BuildApplyConfiguration represents a declarative configuration of the Build type for use
+// with apply.
+type BuildApplyConfiguration struct {
+	// Configures where should the newly built image be stored. Required.
+	Output *BuildOutputApplyConfiguration `json:"output,omitempty"`
+	// List of connector plugins which should be added to the Kafka Connect.
+	// Required.
+	Plugins []BuildPluginApplyConfiguration `json:"plugins,omitempty"`
+	// CPU and memory resources to reserve for the build.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// BuildApplyConfiguration constructs a declarative configuration of the Build type for use with
+// apply.
+func Build() *BuildApplyConfiguration {
+	return &BuildApplyConfiguration{}
+}
+
+// WithOutput sets the Output field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Output field is set to the value of the last call.
+func (b *BuildApplyConfiguration) WithOutput(value *BuildOutputApplyConfiguration) *BuildApplyConfiguration {
+	b.Output = value
+	return b
+}
+
+// WithPlugins adds the given value to the Plugins field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Plugins field.
+func (b *BuildApplyConfiguration) WithPlugins(values ...*BuildPluginApplyConfiguration) *BuildApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPlugins")
+		}
+		b.Plugins = append(b.Plugins, *values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *BuildApplyConfiguration) WithResources(value v1.ResourceRequirements) *BuildApplyConfiguration {
+	b.Resources = &value
+	return b
+}
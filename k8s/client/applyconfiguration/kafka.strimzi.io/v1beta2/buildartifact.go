@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BuildArtifactApplyConfiguration represents a declarative configuration of the BuildArtifact type for use
+// with apply.
+type BuildArtifactApplyConfiguration struct {
+	// Artifact type. Currently, the supported artifact types are `tgz`, `jar`,
+	// `zip`, `other` and `maven`.
+	Type *string `json:"type,omitempty"`
+	// URL of the artifact which will be downloaded. Strimzi does not do any
+	// security scanning of the downloaded artifacts. For security reasons, you
+	// should first verify the artifacts manually and configure the checksum
+	// verification to make sure the same artifact is used in the automated
+	// build. Required for `jar`, `zip`, `tgz` and `other` artifacts. Not
+	// applicable to the `maven` artifact type.
+	URL *string `json:"url,omitempty"`
+	// SHA512 checksum of the artifact. Optional. If specified, the checksum will
+	// be verified while building the new container. If not specified, the
+	// downloaded artifact will not be verified. Not applicable to the `maven`
+	// artifact type.
+	Sha512sum *string `json:"sha512sum,omitempty"`
+	// By default, connections using TLS are verified to check they are secure.
+	// The server certificate used must be valid, trusted, and contain the server
+	// name. By setting this option to `true`, all TLS verification is disabled
+	// and the artifact will be downloaded, even when the server is considered
+	// insecure.
+	Insecure *bool `json:"insecure,omitempty"`
+	// Maven group id. Applicable to the `maven` artifact type only.
+	Group *string `json:"group,omitempty"`
+	// Maven artifact id. Applicable to the `maven` artifact type only.
+	Artifact *string `json:"artifact,omitempty"`
+	// Maven version number. Applicable to the `maven` artifact type only.
+	Version *string `json:"version,omitempty"`
+	// Maven repository to download the artifact from. Applicable to the `maven`
+	// artifact type only.
+	Repository *string `json:"repository,omitempty"`
+	// Name under which the artifact will be stored.
+	FileName *string `json:"fileName,omitempty"`
+}
+
+// BuildArtifactApplyConfiguration constructs a declarative configuration of the BuildArtifact type for use with
+// apply.
+func BuildArtifact() *BuildArtifactApplyConfiguration {
+	return &BuildArtifactApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithType(value string) *BuildArtifactApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithURL(value string) *BuildArtifactApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithSha512sum sets the Sha512sum field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sha512sum field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithSha512sum(value string) *BuildArtifactApplyConfiguration {
+	b.Sha512sum = &value
+	return b
+}
+
+// WithInsecure sets the Insecure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Insecure field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithInsecure(value bool) *BuildArtifactApplyConfiguration {
+	b.Insecure = &value
+	return b
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithGroup(value string) *BuildArtifactApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithArtifact sets the Artifact field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Artifact field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithArtifact(value string) *BuildArtifactApplyConfiguration {
+	b.Artifact = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithVersion(value string) *BuildArtifactApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithRepository sets the Repository field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Repository field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithRepository(value string) *BuildArtifactApplyConfiguration {
+	b.Repository = &value
+	return b
+}
+
+// WithFileName sets the FileName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FileName field is set to the value of the last call.
+func (b *BuildArtifactApplyConfiguration) WithFileName(value string) *BuildArtifactApplyConfiguration {
+	b.FileName = &value
+	return b
+}
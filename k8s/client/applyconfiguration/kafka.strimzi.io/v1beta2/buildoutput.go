@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BuildOutputApplyConfiguration represents a declarative configuration of the BuildOutput type for use
+// with apply.
+type BuildOutputApplyConfiguration struct {
+	// Output type. Must be either `docker` for pushing the newly build image to
+	// Docker compatible registry or `imagestream` for pushing the image to
+	// OpenShift ImageStream. Required.
+	Type *string `json:"type,omitempty"`
+	// The name of the image which will be built. Required.
+	Image *string `json:"image,omitempty"`
+	// Container Registry Secret with the credentials for pushing the newly built
+	// image.
+	PushSecret *string `json:"pushSecret,omitempty"`
+	// Configures additional options which will be passed to the Kaniko executor
+	// when building the new Connect image.
+	AdditionalKanikoOptions []string `json:"additionalKanikoOptions,omitempty"`
+}
+
+// BuildOutputApplyConfiguration constructs a declarative configuration of the BuildOutput type for use with
+// apply.
+func BuildOutput() *BuildOutputApplyConfiguration {
+	return &BuildOutputApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *BuildOutputApplyConfiguration) WithType(value string) *BuildOutputApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *BuildOutputApplyConfiguration) WithImage(value string) *BuildOutputApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithPushSecret sets the PushSecret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PushSecret field is set to the value of the last call.
+func (b *BuildOutputApplyConfiguration) WithPushSecret(value string) *BuildOutputApplyConfiguration {
+	b.PushSecret = &value
+	return b
+}
+
+// WithAdditionalKanikoOptions adds the given value to the AdditionalKanikoOptions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalKanikoOptions field.
+func (b *BuildOutputApplyConfiguration) WithAdditionalKanikoOptions(values ...string) *BuildOutputApplyConfiguration {
+	for i := range values {
+		b.AdditionalKanikoOptions = append(b.AdditionalKanikoOptions, values[i])
+	}
+	return b
+}
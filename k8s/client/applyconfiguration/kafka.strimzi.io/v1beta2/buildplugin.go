@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// BuildPluginApplyConfiguration represents a declarative configuration of the BuildPlugin type for use
+// with apply.
+type BuildPluginApplyConfiguration struct {
+	// The unique name of the connector plugin. Will be used to generate the path
+	// where the connector artifacts will be stored. The name has to be unique
+	// within the KafkaConnect resource.
+	Name *string `json:"name,omitempty"`
+	// List of artifacts which belong to this connector plugin. Required.
+	Artifacts []BuildArtifactApplyConfiguration `json:"artifacts,omitempty"`
+}
+
+// BuildPluginApplyConfiguration constructs a declarative configuration of the BuildPlugin type for use with
+// apply.
+func BuildPlugin() *BuildPluginApplyConfiguration {
+	return &BuildPluginApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *BuildPluginApplyConfiguration) WithName(value string) *BuildPluginApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithArtifacts adds the given value to the Artifacts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Artifacts field.
+func (b *BuildPluginApplyConfiguration) WithArtifacts(values ...*BuildArtifactApplyConfiguration) *BuildPluginApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithArtifacts")
+		}
+		b.Artifacts = append(b.Artifacts, *values[i])
+	}
+	return b
+}
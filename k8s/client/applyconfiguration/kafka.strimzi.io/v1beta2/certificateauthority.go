@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// CertificateAuthorityApplyConfiguration represents a declarative configuration of the CertificateAuthority type for use
+// with apply.
+//
+// CertificateAuthority configures how TLS certificates are used within the cluster.
+type CertificateAuthorityApplyConfiguration struct {
+	// If true then Certificate Authority certificates will be generated
+	// automatically. Otherwise the user will need to provide a Secret with the CA
+	// certificate. Default is true.
+	GenerateCertificateAuthority *bool `json:"generateCertificateAuthority,omitempty"`
+	// If true, the Cluster Operator will generate the secret name for the CA
+	// certificate. Default is true.
+	GenerateSecretOwnerReference *bool `json:"generateSecretOwnerReference,omitempty"`
+	// The number of days generated certificates should be valid for. The default
+	// is 365.
+	ValidityDays *int32 `json:"validityDays,omitempty"`
+	// The number of days in the certificate renewal period. This is the number of
+	// days before the a certificate expires during which renewal actions may be
+	// performed. Default is 30.
+	RenewalDays *int32 `json:"renewalDays,omitempty"`
+	// How should CA certificate expiration be handled when
+	// `generateCertificateAuthority=true`. The default is for a new CA certificate
+	// to be generated reusing the existing private key.
+	CertificateExpirationPolicy *string `json:"certificateExpirationPolicy,omitempty"`
+}
+
+// CertificateAuthorityApplyConfiguration constructs a declarative configuration of the CertificateAuthority type for use with
+// apply.
+func CertificateAuthority() *CertificateAuthorityApplyConfiguration {
+	return &CertificateAuthorityApplyConfiguration{}
+}
+
+// WithGenerateCertificateAuthority sets the GenerateCertificateAuthority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateCertificateAuthority field is set to the value of the last call.
+func (b *CertificateAuthorityApplyConfiguration) WithGenerateCertificateAuthority(value bool) *CertificateAuthorityApplyConfiguration {
+	b.GenerateCertificateAuthority = &value
+	return b
+}
+
+// WithGenerateSecretOwnerReference sets the GenerateSecretOwnerReference field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateSecretOwnerReference field is set to the value of the last call.
+func (b *CertificateAuthorityApplyConfiguration) WithGenerateSecretOwnerReference(value bool) *CertificateAuthorityApplyConfiguration {
+	b.GenerateSecretOwnerReference = &value
+	return b
+}
+
+// WithValidityDays sets the ValidityDays field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValidityDays field is set to the value of the last call.
+func (b *CertificateAuthorityApplyConfiguration) WithValidityDays(value int32) *CertificateAuthorityApplyConfiguration {
+	b.ValidityDays = &value
+	return b
+}
+
+// WithRenewalDays sets the RenewalDays field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RenewalDays field is set to the value of the last call.
+func (b *CertificateAuthorityApplyConfiguration) WithRenewalDays(value int32) *CertificateAuthorityApplyConfiguration {
+	b.RenewalDays = &value
+	return b
+}
+
+// WithCertificateExpirationPolicy sets the CertificateExpirationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateExpirationPolicy field is set to the value of the last call.
+func (b *CertificateAuthorityApplyConfiguration) WithCertificateExpirationPolicy(value string) *CertificateAuthorityApplyConfiguration {
+	b.CertificateExpirationPolicy = &value
+	return b
+}
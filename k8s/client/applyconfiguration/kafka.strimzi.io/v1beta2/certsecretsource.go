@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// CertSecretSourceApplyConfiguration represents a declarative configuration of the CertSecretSource type for use
+// with apply.
+type CertSecretSourceApplyConfiguration struct {
+	// The name of the Secret containing the certificate.
+	SecretName *string `json:"secretName,omitempty"`
+	// The name of the file certificate in the secret.
+	Certificate *string `json:"certificate,omitempty"`
+	// Pattern for the certificate files in the secret. Use the glob syntax for
+	// the pattern. All files in the secret that match the pattern are used.
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// CertSecretSourceApplyConfiguration constructs a declarative configuration of the CertSecretSource type for use with
+// apply.
+func CertSecretSource() *CertSecretSourceApplyConfiguration {
+	return &CertSecretSourceApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *CertSecretSourceApplyConfiguration) WithSecretName(value string) *CertSecretSourceApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithCertificate sets the Certificate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Certificate field is set to the value of the last call.
+func (b *CertSecretSourceApplyConfiguration) WithCertificate(value string) *CertSecretSourceApplyConfiguration {
+	b.Certificate = &value
+	return b
+}
+
+// WithPattern sets the Pattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pattern field is set to the value of the last call.
+func (b *CertSecretSourceApplyConfiguration) WithPattern(value string) *CertSecretSourceApplyConfiguration {
+	b.Pattern = &value
+	return b
+}
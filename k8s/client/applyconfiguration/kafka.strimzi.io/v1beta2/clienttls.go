@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ClientTLSApplyConfiguration represents a declarative configuration of the ClientTLS type for use
+// with apply.
+//
+// ClientTLS configures TLS trusted certificates for connecting to a Kafka cluster.
+type ClientTLSApplyConfiguration struct {
+	// Trusted certificates for TLS connection.
+	TrustedCertificates []CertSecretSourceApplyConfiguration `json:"trustedCertificates,omitempty"`
+}
+
+// ClientTLSApplyConfiguration constructs a declarative configuration of the ClientTLS type for use with
+// apply.
+func ClientTLS() *ClientTLSApplyConfiguration {
+	return &ClientTLSApplyConfiguration{}
+}
+
+// WithTrustedCertificates adds the given value to the TrustedCertificates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrustedCertificates field.
+func (b *ClientTLSApplyConfiguration) WithTrustedCertificates(values ...*CertSecretSourceApplyConfiguration) *ClientTLSApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTrustedCertificates")
+		}
+		b.TrustedCertificates = append(b.TrustedCertificates, *values[i])
+	}
+	return b
+}
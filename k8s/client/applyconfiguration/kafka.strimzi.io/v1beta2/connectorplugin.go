@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ConnectorPluginApplyConfiguration represents a declarative configuration of the ConnectorPlugin type for use
+// with apply.
+type ConnectorPluginApplyConfiguration struct {
+	// The class of the connector plugin.
+	Class *string `json:"class,omitempty"`
+	// The type of the connector plugin. The available types are `sink` and
+	// `source`.
+	Type *string `json:"type,omitempty"`
+	// The version of the connector plugin.
+	Version *string `json:"version,omitempty"`
+}
+
+// ConnectorPluginApplyConfiguration constructs a declarative configuration of the ConnectorPlugin type for use with
+// apply.
+func ConnectorPlugin() *ConnectorPluginApplyConfiguration {
+	return &ConnectorPluginApplyConfiguration{}
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *ConnectorPluginApplyConfiguration) WithClass(value string) *ConnectorPluginApplyConfiguration {
+	b.Class = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ConnectorPluginApplyConfiguration) WithType(value string) *ConnectorPluginApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *ConnectorPluginApplyConfiguration) WithVersion(value string) *ConnectorPluginApplyConfiguration {
+	b.Version = &value
+	return b
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ConnectorStateApplyConfiguration represents a declarative configuration of the ConnectorState type for use
+// with apply.
+type ConnectorStateApplyConfiguration struct {
+	// The state of the connector, for example RUNNING, PAUSED, STOPPED or FAILED.
+	State *string `json:"state,omitempty"`
+	// The worker the connector is running on.
+	WorkerID *string `json:"worker_id,omitempty"`
+	// The stack trace of the failure, if the connector failed.
+	Trace *string `json:"trace,omitempty"`
+}
+
+// ConnectorStateApplyConfiguration constructs a declarative configuration of the ConnectorState type for use with
+// apply.
+func ConnectorState() *ConnectorStateApplyConfiguration {
+	return &ConnectorStateApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ConnectorStateApplyConfiguration) WithState(value string) *ConnectorStateApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithWorkerID sets the WorkerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerID field is set to the value of the last call.
+func (b *ConnectorStateApplyConfiguration) WithWorkerID(value string) *ConnectorStateApplyConfiguration {
+	b.WorkerID = &value
+	return b
+}
+
+// WithTrace sets the Trace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Trace field is set to the value of the last call.
+func (b *ConnectorStateApplyConfiguration) WithTrace(value string) *ConnectorStateApplyConfiguration {
+	b.Trace = &value
+	return b
+}
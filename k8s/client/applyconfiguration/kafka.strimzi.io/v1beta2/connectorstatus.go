@@ -0,0 +1,65 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ConnectorStatusApplyConfiguration represents a declarative configuration of the ConnectorStatus type for use
+// with apply.
+//
+// ConnectorStatus is the status of a connector and its tasks as reported by the
+// Kafka Connect REST API.
+type ConnectorStatusApplyConfiguration struct {
+	// The name of the connector.
+	Name *string `json:"name,omitempty"`
+	// The state of the connector.
+	Connector *ConnectorStateApplyConfiguration `json:"connector,omitempty"`
+	// The state of the connector tasks.
+	Tasks []ConnectorTaskStateApplyConfiguration `json:"tasks,omitempty"`
+	// The type of the connector, either `source` or `sink`.
+	Type *string `json:"type,omitempty"`
+}
+
+// ConnectorStatusApplyConfiguration constructs a declarative configuration of the ConnectorStatus type for use with
+// apply.
+func ConnectorStatus() *ConnectorStatusApplyConfiguration {
+	return &ConnectorStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ConnectorStatusApplyConfiguration) WithName(value string) *ConnectorStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithConnector sets the Connector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Connector field is set to the value of the last call.
+func (b *ConnectorStatusApplyConfiguration) WithConnector(value *ConnectorStateApplyConfiguration) *ConnectorStatusApplyConfiguration {
+	b.Connector = value
+	return b
+}
+
+// WithTasks adds the given value to the Tasks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tasks field.
+func (b *ConnectorStatusApplyConfiguration) WithTasks(values ...*ConnectorTaskStateApplyConfiguration) *ConnectorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTasks")
+		}
+		b.Tasks = append(b.Tasks, *values[i])
+	}
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *ConnectorStatusApplyConfiguration) WithType(value string) *ConnectorStatusApplyConfiguration {
+	b.Type = &value
+	return b
+}
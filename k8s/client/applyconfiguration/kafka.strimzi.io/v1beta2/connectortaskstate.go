@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ConnectorTaskStateApplyConfiguration represents a declarative configuration of the ConnectorTaskState type for use
+// with apply.
+type ConnectorTaskStateApplyConfiguration struct {
+	// The id of the task.
+	ID *int32 `json:"id,omitempty"`
+	// The state of the task, for example RUNNING, PAUSED or FAILED.
+	State *string `json:"state,omitempty"`
+	// The worker the task is running on.
+	WorkerID *string `json:"worker_id,omitempty"`
+	// The stack trace of the failure, if the task failed.
+	Trace *string `json:"trace,omitempty"`
+}
+
+// ConnectorTaskStateApplyConfiguration constructs a declarative configuration of the ConnectorTaskState type for use with
+// apply.
+func ConnectorTaskState() *ConnectorTaskStateApplyConfiguration {
+	return &ConnectorTaskStateApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *ConnectorTaskStateApplyConfiguration) WithID(value int32) *ConnectorTaskStateApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *ConnectorTaskStateApplyConfiguration) WithState(value string) *ConnectorTaskStateApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithWorkerID sets the WorkerID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerID field is set to the value of the last call.
+func (b *ConnectorTaskStateApplyConfiguration) WithWorkerID(value string) *ConnectorTaskStateApplyConfiguration {
+	b.WorkerID = &value
+	return b
+}
+
+// WithTrace sets the Trace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Trace field is set to the value of the last call.
+func (b *ConnectorTaskStateApplyConfiguration) WithTrace(value string) *ConnectorTaskStateApplyConfiguration {
+	b.Trace = &value
+	return b
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// CruiseControlSpecApplyConfiguration represents a declarative configuration of the CruiseControlSpec type for use
+// with apply.
+type CruiseControlSpecApplyConfiguration struct {
+	// The container image used for Cruise Control pods.
+	Image *string `json:"image,omitempty"`
+	// CPU and memory resources to reserve for the Cruise Control container.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Pod liveness checking for the Cruise Control container.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking for the Cruise Control container.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// JVM Options for the Cruise Control container.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// Logging configuration (Log4j 2) for Cruise Control.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// Template to specify how Cruise Control resources, `Deployments` and
+	// `Pods`, are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+	// The Cruise Control `brokerCapacity` configuration.
+	BrokerCapacity *runtime.RawExtension `json:"brokerCapacity,omitempty"`
+	// The Cruise Control configuration.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Metrics configuration.
+	MetricsConfig *MetricsConfigApplyConfiguration `json:"metricsConfig,omitempty"`
+	// Configuration of the Cruise Control REST API users.
+	APIUsers *runtime.RawExtension `json:"apiUsers,omitempty"`
+	// Auto-rebalancing on scaling related configuration listing the modes, when
+	// brokers are added or removed, with the corresponding rebalance template
+	// configurations.
+	AutoRebalance []KafkaAutoRebalanceConfigurationApplyConfiguration `json:"autoRebalance,omitempty"`
+}
+
+// CruiseControlSpecApplyConfiguration constructs a declarative configuration of the CruiseControlSpec type for use with
+// apply.
+func CruiseControlSpec() *CruiseControlSpecApplyConfiguration {
+	return &CruiseControlSpecApplyConfiguration{}
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithImage(value string) *CruiseControlSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *CruiseControlSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *CruiseControlSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithBrokerCapacity sets the BrokerCapacity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BrokerCapacity field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithBrokerCapacity(value runtime.RawExtension) *CruiseControlSpecApplyConfiguration {
+	b.BrokerCapacity = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *CruiseControlSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithMetricsConfig sets the MetricsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsConfig field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithMetricsConfig(value *MetricsConfigApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	b.MetricsConfig = value
+	return b
+}
+
+// WithAPIUsers sets the APIUsers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIUsers field is set to the value of the last call.
+func (b *CruiseControlSpecApplyConfiguration) WithAPIUsers(value runtime.RawExtension) *CruiseControlSpecApplyConfiguration {
+	b.APIUsers = &value
+	return b
+}
+
+// WithAutoRebalance adds the given value to the AutoRebalance field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AutoRebalance field.
+func (b *CruiseControlSpecApplyConfiguration) WithAutoRebalance(values ...*KafkaAutoRebalanceConfigurationApplyConfiguration) *CruiseControlSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAutoRebalance")
+		}
+		b.AutoRebalance = append(b.AutoRebalance, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// EntityOperatorSpecApplyConfiguration represents a declarative configuration of the EntityOperatorSpec type for use
+// with apply.
+type EntityOperatorSpecApplyConfiguration struct {
+	// Configuration of the Topic Operator.
+	TopicOperator *EntityTopicOperatorSpecApplyConfiguration `json:"topicOperator,omitempty"`
+	// Configuration of the User Operator.
+	UserOperator *EntityUserOperatorSpecApplyConfiguration `json:"userOperator,omitempty"`
+	// TLS sidecar configuration. The TLS sidecar is not used anymore and this
+	// option will be ignored.
+	TLSSidecar *runtime.RawExtension `json:"tlsSidecar,omitempty"`
+	// Template for Entity Operator resources. The template allows users to
+	// specify how a `Deployment` and `Pod` is generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// EntityOperatorSpecApplyConfiguration constructs a declarative configuration of the EntityOperatorSpec type for use with
+// apply.
+func EntityOperatorSpec() *EntityOperatorSpecApplyConfiguration {
+	return &EntityOperatorSpecApplyConfiguration{}
+}
+
+// WithTopicOperator sets the TopicOperator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopicOperator field is set to the value of the last call.
+func (b *EntityOperatorSpecApplyConfiguration) WithTopicOperator(value *EntityTopicOperatorSpecApplyConfiguration) *EntityOperatorSpecApplyConfiguration {
+	b.TopicOperator = value
+	return b
+}
+
+// WithUserOperator sets the UserOperator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserOperator field is set to the value of the last call.
+func (b *EntityOperatorSpecApplyConfiguration) WithUserOperator(value *EntityUserOperatorSpecApplyConfiguration) *EntityOperatorSpecApplyConfiguration {
+	b.UserOperator = value
+	return b
+}
+
+// WithTLSSidecar sets the TLSSidecar field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLSSidecar field is set to the value of the last call.
+func (b *EntityOperatorSpecApplyConfiguration) WithTLSSidecar(value runtime.RawExtension) *EntityOperatorSpecApplyConfiguration {
+	b.TLSSidecar = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *EntityOperatorSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *EntityOperatorSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// EntityTopicOperatorSpecApplyConfiguration represents a declarative configuration of the EntityTopicOperatorSpec type for use
+// with apply.
+type EntityTopicOperatorSpecApplyConfiguration struct {
+	// The namespace the Topic Operator should watch.
+	WatchedNamespace *string `json:"watchedNamespace,omitempty"`
+	// The image to use for the Topic Operator.
+	Image *string `json:"image,omitempty"`
+	// Interval between periodic reconciliations in milliseconds.
+	ReconciliationIntervalMs *int64 `json:"reconciliationIntervalMs,omitempty"`
+	// Timeout for the ZooKeeper session.
+	ZookeeperSessionTimeoutSeconds *int32 `json:"zookeeperSessionTimeoutSeconds,omitempty"`
+	// Pod startup checking.
+	StartupProbe *ProbeApplyConfiguration `json:"startupProbe,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// The number of attempts at getting topic metadata.
+	TopicMetadataMaxAttempts *int32 `json:"topicMetadataMaxAttempts,omitempty"`
+	// Logging configuration.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+}
+
+// EntityTopicOperatorSpecApplyConfiguration constructs a declarative configuration of the EntityTopicOperatorSpec type for use with
+// apply.
+func EntityTopicOperatorSpec() *EntityTopicOperatorSpecApplyConfiguration {
+	return &EntityTopicOperatorSpecApplyConfiguration{}
+}
+
+// WithWatchedNamespace sets the WatchedNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WatchedNamespace field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithWatchedNamespace(value string) *EntityTopicOperatorSpecApplyConfiguration {
+	b.WatchedNamespace = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithImage(value string) *EntityTopicOperatorSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithReconciliationIntervalMs sets the ReconciliationIntervalMs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReconciliationIntervalMs field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithReconciliationIntervalMs(value int64) *EntityTopicOperatorSpecApplyConfiguration {
+	b.ReconciliationIntervalMs = &value
+	return b
+}
+
+// WithZookeeperSessionTimeoutSeconds sets the ZookeeperSessionTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ZookeeperSessionTimeoutSeconds field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithZookeeperSessionTimeoutSeconds(value int32) *EntityTopicOperatorSpecApplyConfiguration {
+	b.ZookeeperSessionTimeoutSeconds = &value
+	return b
+}
+
+// WithStartupProbe sets the StartupProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartupProbe field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithStartupProbe(value *ProbeApplyConfiguration) *EntityTopicOperatorSpecApplyConfiguration {
+	b.StartupProbe = value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *EntityTopicOperatorSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *EntityTopicOperatorSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *EntityTopicOperatorSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithTopicMetadataMaxAttempts sets the TopicMetadataMaxAttempts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopicMetadataMaxAttempts field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithTopicMetadataMaxAttempts(value int32) *EntityTopicOperatorSpecApplyConfiguration {
+	b.TopicMetadataMaxAttempts = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *EntityTopicOperatorSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *EntityTopicOperatorSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *EntityTopicOperatorSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
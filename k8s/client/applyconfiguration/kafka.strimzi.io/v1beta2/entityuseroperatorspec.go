@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// EntityUserOperatorSpecApplyConfiguration represents a declarative configuration of the EntityUserOperatorSpec type for use
+// with apply.
+type EntityUserOperatorSpecApplyConfiguration struct {
+	// The namespace the User Operator should watch.
+	WatchedNamespace *string `json:"watchedNamespace,omitempty"`
+	// The image to use for the User Operator.
+	Image *string `json:"image,omitempty"`
+	// Interval between periodic reconciliations in milliseconds.
+	ReconciliationIntervalMs *int64 `json:"reconciliationIntervalMs,omitempty"`
+	// The prefix that will be added to the KafkaUser name to be used as the
+	// Secret name.
+	SecretPrefix *string `json:"secretPrefix,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Logging configuration.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+}
+
+// EntityUserOperatorSpecApplyConfiguration constructs a declarative configuration of the EntityUserOperatorSpec type for use with
+// apply.
+func EntityUserOperatorSpec() *EntityUserOperatorSpecApplyConfiguration {
+	return &EntityUserOperatorSpecApplyConfiguration{}
+}
+
+// WithWatchedNamespace sets the WatchedNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WatchedNamespace field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithWatchedNamespace(value string) *EntityUserOperatorSpecApplyConfiguration {
+	b.WatchedNamespace = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithImage(value string) *EntityUserOperatorSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithReconciliationIntervalMs sets the ReconciliationIntervalMs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReconciliationIntervalMs field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithReconciliationIntervalMs(value int64) *EntityUserOperatorSpecApplyConfiguration {
+	b.ReconciliationIntervalMs = &value
+	return b
+}
+
+// WithSecretPrefix sets the SecretPrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretPrefix field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithSecretPrefix(value string) *EntityUserOperatorSpecApplyConfiguration {
+	b.SecretPrefix = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *EntityUserOperatorSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *EntityUserOperatorSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *EntityUserOperatorSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *EntityUserOperatorSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *EntityUserOperatorSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *EntityUserOperatorSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
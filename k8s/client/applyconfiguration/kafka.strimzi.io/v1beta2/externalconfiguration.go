@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ExternalConfigurationApplyConfiguration represents a declarative configuration of the ExternalConfiguration type for use
+// with apply.
+type ExternalConfigurationApplyConfiguration struct {
+	// Makes data from a Secret or ConfigMap available in the Kafka Connect pods
+	// as environment variables.
+	Env []ExternalConfigurationEnvApplyConfiguration `json:"env,omitempty"`
+	// Makes data from a Secret or ConfigMap available in the Kafka Connect pods
+	// as volumes.
+	Volumes []ExternalConfigurationVolumeSourceApplyConfiguration `json:"volumes,omitempty"`
+}
+
+// ExternalConfigurationApplyConfiguration constructs a declarative configuration of the ExternalConfiguration type for use with
+// apply.
+func ExternalConfiguration() *ExternalConfigurationApplyConfiguration {
+	return &ExternalConfigurationApplyConfiguration{}
+}
+
+// WithEnv adds the given value to the Env field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Env field.
+func (b *ExternalConfigurationApplyConfiguration) WithEnv(values ...*ExternalConfigurationEnvApplyConfiguration) *ExternalConfigurationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEnv")
+		}
+		b.Env = append(b.Env, *values[i])
+	}
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *ExternalConfigurationApplyConfiguration) WithVolumes(values ...*ExternalConfigurationVolumeSourceApplyConfiguration) *ExternalConfigurationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
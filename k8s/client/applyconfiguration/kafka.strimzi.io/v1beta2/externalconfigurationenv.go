@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ExternalConfigurationEnvApplyConfiguration represents a declarative configuration of the ExternalConfigurationEnv type for use
+// with apply.
+type ExternalConfigurationEnvApplyConfiguration struct {
+	// Name of the environment variable which will be passed to the Kafka Connect
+	// pods. The name of the environment variable cannot start with `KAFKA_` or
+	// `STRIMZI_`.
+	Name *string `json:"name,omitempty"`
+	// Value of the environment variable which will be passed to the Kafka
+	// Connect pods. It can be passed either as a reference to Secret or
+	// ConfigMap field. The field has to specify exactly one Secret or ConfigMap.
+	ValueFrom *ExternalConfigurationEnvVarSourceApplyConfiguration `json:"valueFrom,omitempty"`
+}
+
+// ExternalConfigurationEnvApplyConfiguration constructs a declarative configuration of the ExternalConfigurationEnv type for use with
+// apply.
+func ExternalConfigurationEnv() *ExternalConfigurationEnvApplyConfiguration {
+	return &ExternalConfigurationEnvApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ExternalConfigurationEnvApplyConfiguration) WithName(value string) *ExternalConfigurationEnvApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValueFrom sets the ValueFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValueFrom field is set to the value of the last call.
+func (b *ExternalConfigurationEnvApplyConfiguration) WithValueFrom(value *ExternalConfigurationEnvVarSourceApplyConfiguration) *ExternalConfigurationEnvApplyConfiguration {
+	b.ValueFrom = value
+	return b
+}
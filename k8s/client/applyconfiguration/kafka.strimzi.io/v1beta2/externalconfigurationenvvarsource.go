@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ExternalConfigurationEnvVarSourceApplyConfiguration represents a declarative configuration of the ExternalConfigurationEnvVarSource type for use
+// with apply.
+type ExternalConfigurationEnvVarSourceApplyConfiguration struct {
+	// Reference to a key in a Secret.
+	SecretKeyRef *v1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// Reference to a key in a ConfigMap.
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ExternalConfigurationEnvVarSourceApplyConfiguration constructs a declarative configuration of the ExternalConfigurationEnvVarSource type for use with
+// apply.
+func ExternalConfigurationEnvVarSource() *ExternalConfigurationEnvVarSourceApplyConfiguration {
+	return &ExternalConfigurationEnvVarSourceApplyConfiguration{}
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *ExternalConfigurationEnvVarSourceApplyConfiguration) WithSecretKeyRef(value v1.SecretKeySelector) *ExternalConfigurationEnvVarSourceApplyConfiguration {
+	b.SecretKeyRef = &value
+	return b
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *ExternalConfigurationEnvVarSourceApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *ExternalConfigurationEnvVarSourceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ExternalConfigurationReferenceApplyConfiguration represents a declarative configuration of the ExternalConfigurationReference type for use
+// with apply.
+//
+// ExternalConfigurationReference references a ConfigMap key holding configuration.
+type ExternalConfigurationReferenceApplyConfiguration struct {
+	// Reference to the key in the ConfigMap containing the configuration.
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+}
+
+// ExternalConfigurationReferenceApplyConfiguration constructs a declarative configuration of the ExternalConfigurationReference type for use with
+// apply.
+func ExternalConfigurationReference() *ExternalConfigurationReferenceApplyConfiguration {
+	return &ExternalConfigurationReferenceApplyConfiguration{}
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *ExternalConfigurationReferenceApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *ExternalConfigurationReferenceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ExternalConfigurationVolumeSourceApplyConfiguration represents a declarative configuration of the ExternalConfigurationVolumeSource type for use
+// with apply.
+type ExternalConfigurationVolumeSourceApplyConfiguration struct {
+	// Name of the volume which will be added to the Kafka Connect pods.
+	Name *string `json:"name,omitempty"`
+	// Reference to a key in a Secret. Exactly one Secret or ConfigMap has to be
+	// specified.
+	Secret *v1.SecretVolumeSource `json:"secret,omitempty"`
+	// Reference to a key in a ConfigMap. Exactly one Secret or ConfigMap has to
+	// be specified.
+	ConfigMap *v1.ConfigMapVolumeSource `json:"configMap,omitempty"`
+}
+
+// ExternalConfigurationVolumeSourceApplyConfiguration constructs a declarative configuration of the ExternalConfigurationVolumeSource type for use with
+// apply.
+func ExternalConfigurationVolumeSource() *ExternalConfigurationVolumeSourceApplyConfiguration {
+	return &ExternalConfigurationVolumeSourceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ExternalConfigurationVolumeSourceApplyConfiguration) WithName(value string) *ExternalConfigurationVolumeSourceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *ExternalConfigurationVolumeSourceApplyConfiguration) WithSecret(value v1.SecretVolumeSource) *ExternalConfigurationVolumeSourceApplyConfiguration {
+	b.Secret = &value
+	return b
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *ExternalConfigurationVolumeSourceApplyConfiguration) WithConfigMap(value v1.ConfigMapVolumeSource) *ExternalConfigurationVolumeSourceApplyConfiguration {
+	b.ConfigMap = &value
+	return b
+}
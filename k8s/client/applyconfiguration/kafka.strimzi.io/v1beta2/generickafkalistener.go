@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// GenericKafkaListenerApplyConfiguration represents a declarative configuration of the GenericKafkaListener type for use
+// with apply.
+//
+// GenericKafkaListener configures a listener of Kafka brokers.
+type GenericKafkaListenerApplyConfiguration struct {
+	// Name of the listener. The name will be used to identify the listener and
+	// the related Kubernetes objects. The name has to be unique within given a
+	// Kafka cluster. The name can consist of lowercase characters and numbers and
+	// be up to 11 characters long.
+	Name *string `json:"name,omitempty"`
+	// Port number used by the listener inside Kafka. The port number has to be
+	// unique within a given Kafka cluster. Allowed port numbers are 9092 and
+	// higher with the exception of ports 9404 and 9999, which are already used
+	// for Prometheus and JMX. Depending on the listener type, the port number
+	// might not be the same as the port number that connects Kafka clients.
+	Port *int32 `json:"port,omitempty"`
+	// Type of the listener. The supported types are `internal`, `route`,
+	// `loadbalancer`, `nodeport`, `ingress` and `cluster-ip`.
+	Type *string `json:"type,omitempty"`
+	// Enables TLS encryption on the listener. This is a required property.
+	TLS *bool `json:"tls,omitempty"`
+	// Authentication configuration for this listener.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+	// Additional listener configuration.
+	Configuration *runtime.RawExtension `json:"configuration,omitempty"`
+	// List of peers which should be able to connect to this listener. Peers in
+	// this list are combined using a logical OR operation. If this field is empty
+	// or missing, all connections will be allowed for this listener.
+	NetworkPolicyPeers *runtime.RawExtension `json:"networkPolicyPeers,omitempty"`
+}
+
+// GenericKafkaListenerApplyConfiguration constructs a declarative configuration of the GenericKafkaListener type for use with
+// apply.
+func GenericKafkaListener() *GenericKafkaListenerApplyConfiguration {
+	return &GenericKafkaListenerApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithName(value string) *GenericKafkaListenerApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithPort(value int32) *GenericKafkaListenerApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithType(value string) *GenericKafkaListenerApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithTLS(value bool) *GenericKafkaListenerApplyConfiguration {
+	b.TLS = &value
+	return b
+}
+
+// WithAuthentication sets the Authentication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authentication field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithAuthentication(value runtime.RawExtension) *GenericKafkaListenerApplyConfiguration {
+	b.Authentication = &value
+	return b
+}
+
+// WithConfiguration sets the Configuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Configuration field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithConfiguration(value runtime.RawExtension) *GenericKafkaListenerApplyConfiguration {
+	b.Configuration = &value
+	return b
+}
+
+// WithNetworkPolicyPeers sets the NetworkPolicyPeers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicyPeers field is set to the value of the last call.
+func (b *GenericKafkaListenerApplyConfiguration) WithNetworkPolicyPeers(value runtime.RawExtension) *GenericKafkaListenerApplyConfiguration {
+	b.NetworkPolicyPeers = &value
+	return b
+}
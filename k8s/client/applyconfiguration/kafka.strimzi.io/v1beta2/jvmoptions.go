@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// JvmOptionsApplyConfiguration represents a declarative configuration of the JvmOptions type for use
+// with apply.
+//
+// JvmOptions configures the JVM of a Strimzi managed container.
+type JvmOptionsApplyConfiguration struct {
+	// A map of -XX options to the JVM.
+	XX map[string]string `json:"-XX,omitempty"`
+	// -Xms option to to the JVM.
+	Xms *string `json:"-Xms,omitempty"`
+	// -Xmx option to to the JVM.
+	Xmx *string `json:"-Xmx,omitempty"`
+	// Specifies whether the Garbage Collection logging is enabled. The default is
+	// false.
+	GcLoggingEnabled *bool `json:"gcLoggingEnabled,omitempty"`
+	// A map of additional system properties which will be passed using the `-D`
+	// option to the JVM.
+	JavaSystemProperties []SystemPropertyApplyConfiguration `json:"javaSystemProperties,omitempty"`
+}
+
+// JvmOptionsApplyConfiguration constructs a declarative configuration of the JvmOptions type for use with
+// apply.
+func JvmOptions() *JvmOptionsApplyConfiguration {
+	return &JvmOptionsApplyConfiguration{}
+}
+
+// WithXX puts the entries into the XX field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the XX field,
+// overwriting an existing map entries in XX field with the same key.
+func (b *JvmOptionsApplyConfiguration) WithXX(entries map[string]string) *JvmOptionsApplyConfiguration {
+	if b.XX == nil && len(entries) > 0 {
+		b.XX = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.XX[k] = v
+	}
+	return b
+}
+
+// WithXms sets the Xms field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Xms field is set to the value of the last call.
+func (b *JvmOptionsApplyConfiguration) WithXms(value string) *JvmOptionsApplyConfiguration {
+	b.Xms = &value
+	return b
+}
+
+// WithXmx sets the Xmx field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Xmx field is set to the value of the last call.
+func (b *JvmOptionsApplyConfiguration) WithXmx(value string) *JvmOptionsApplyConfiguration {
+	b.Xmx = &value
+	return b
+}
+
+// WithGcLoggingEnabled sets the GcLoggingEnabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GcLoggingEnabled field is set to the value of the last call.
+func (b *JvmOptionsApplyConfiguration) WithGcLoggingEnabled(value bool) *JvmOptionsApplyConfiguration {
+	b.GcLoggingEnabled = &value
+	return b
+}
+
+// WithJavaSystemProperties adds the given value to the JavaSystemProperties field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the JavaSystemProperties field.
+func (b *JvmOptionsApplyConfiguration) WithJavaSystemProperties(values ...*SystemPropertyApplyConfiguration) *JvmOptionsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithJavaSystemProperties")
+		}
+		b.JavaSystemProperties = append(b.JavaSystemProperties, *values[i])
+	}
+	return b
+}
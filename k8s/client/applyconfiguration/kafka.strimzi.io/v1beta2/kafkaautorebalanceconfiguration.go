@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// KafkaAutoRebalanceConfigurationApplyConfiguration represents a declarative configuration of the KafkaAutoRebalanceConfiguration type for use
+// with apply.
+type KafkaAutoRebalanceConfigurationApplyConfiguration struct {
+	// Specifies the mode for automatically rebalancing when brokers are added or
+	// removed. Supported modes are `add-brokers` and `remove-brokers`.
+	Mode *string `json:"mode,omitempty"`
+	// Reference to the KafkaRebalance custom resource to be used as the
+	// configuration template for the auto-rebalancing on scaling when running
+	// for the corresponding mode.
+	Template *v1.LocalObjectReference `json:"template,omitempty"`
+}
+
+// KafkaAutoRebalanceConfigurationApplyConfiguration constructs a declarative configuration of the KafkaAutoRebalanceConfiguration type for use with
+// apply.
+func KafkaAutoRebalanceConfiguration() *KafkaAutoRebalanceConfigurationApplyConfiguration {
+	return &KafkaAutoRebalanceConfigurationApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *KafkaAutoRebalanceConfigurationApplyConfiguration) WithMode(value string) *KafkaAutoRebalanceConfigurationApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaAutoRebalanceConfigurationApplyConfiguration) WithTemplate(value v1.LocalObjectReference) *KafkaAutoRebalanceConfigurationApplyConfiguration {
+	b.Template = &value
+	return b
+}
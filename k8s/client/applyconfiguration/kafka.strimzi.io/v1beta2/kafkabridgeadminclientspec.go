@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaBridgeAdminClientSpecApplyConfiguration represents a declarative configuration of the KafkaBridgeAdminClientSpec type for use
+// with apply.
+type KafkaBridgeAdminClientSpecApplyConfiguration struct {
+	// The Kafka AdminClient configuration used for AdminClient instances
+	// created by the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+// KafkaBridgeAdminClientSpecApplyConfiguration constructs a declarative configuration of the KafkaBridgeAdminClientSpec type for use with
+// apply.
+func KafkaBridgeAdminClientSpec() *KafkaBridgeAdminClientSpecApplyConfiguration {
+	return &KafkaBridgeAdminClientSpecApplyConfiguration{}
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaBridgeAdminClientSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaBridgeAdminClientSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaBridgeConsumerSpecApplyConfiguration represents a declarative configuration of the KafkaBridgeConsumerSpec type for use
+// with apply.
+type KafkaBridgeConsumerSpecApplyConfiguration struct {
+	// Whether the HTTP consumer should be enabled or disabled. The default is
+	// enabled (`true`).
+	Enabled *bool `json:"enabled,omitempty"`
+	// The timeout in seconds for deleting inactive consumers, default is -1
+	// (disabled).
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// The Kafka consumer configuration used for consumer instances created by
+	// the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+// KafkaBridgeConsumerSpecApplyConfiguration constructs a declarative configuration of the KafkaBridgeConsumerSpec type for use with
+// apply.
+func KafkaBridgeConsumerSpec() *KafkaBridgeConsumerSpecApplyConfiguration {
+	return &KafkaBridgeConsumerSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *KafkaBridgeConsumerSpecApplyConfiguration) WithEnabled(value bool) *KafkaBridgeConsumerSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithTimeoutSeconds sets the TimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutSeconds field is set to the value of the last call.
+func (b *KafkaBridgeConsumerSpecApplyConfiguration) WithTimeoutSeconds(value int64) *KafkaBridgeConsumerSpecApplyConfiguration {
+	b.TimeoutSeconds = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaBridgeConsumerSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaBridgeConsumerSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaBridgeHTTPConfigApplyConfiguration represents a declarative configuration of the KafkaBridgeHTTPConfig type for use
+// with apply.
+type KafkaBridgeHTTPConfigApplyConfiguration struct {
+	// The port which is the server listening on.
+	Port *int32 `json:"port,omitempty"`
+	// CORS configuration for the HTTP Bridge.
+	Cors *KafkaBridgeHTTPCorsApplyConfiguration `json:"cors,omitempty"`
+}
+
+// KafkaBridgeHTTPConfigApplyConfiguration constructs a declarative configuration of the KafkaBridgeHTTPConfig type for use with
+// apply.
+func KafkaBridgeHTTPConfig() *KafkaBridgeHTTPConfigApplyConfiguration {
+	return &KafkaBridgeHTTPConfigApplyConfiguration{}
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *KafkaBridgeHTTPConfigApplyConfiguration) WithPort(value int32) *KafkaBridgeHTTPConfigApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithCors sets the Cors field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cors field is set to the value of the last call.
+func (b *KafkaBridgeHTTPConfigApplyConfiguration) WithCors(value *KafkaBridgeHTTPCorsApplyConfiguration) *KafkaBridgeHTTPConfigApplyConfiguration {
+	b.Cors = value
+	return b
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaBridgeHTTPCorsApplyConfiguration represents a declarative configuration of the KafkaBridgeHTTPCors type for use
+// with apply.
+type KafkaBridgeHTTPCorsApplyConfiguration struct {
+	// List of allowed origins. Java regular expressions can be used.
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+	// List of allowed HTTP methods.
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+}
+
+// KafkaBridgeHTTPCorsApplyConfiguration constructs a declarative configuration of the KafkaBridgeHTTPCors type for use with
+// apply.
+func KafkaBridgeHTTPCors() *KafkaBridgeHTTPCorsApplyConfiguration {
+	return &KafkaBridgeHTTPCorsApplyConfiguration{}
+}
+
+// WithAllowedOrigins adds the given value to the AllowedOrigins field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedOrigins field.
+func (b *KafkaBridgeHTTPCorsApplyConfiguration) WithAllowedOrigins(values ...string) *KafkaBridgeHTTPCorsApplyConfiguration {
+	for i := range values {
+		b.AllowedOrigins = append(b.AllowedOrigins, values[i])
+	}
+	return b
+}
+
+// WithAllowedMethods adds the given value to the AllowedMethods field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedMethods field.
+func (b *KafkaBridgeHTTPCorsApplyConfiguration) WithAllowedMethods(values ...string) *KafkaBridgeHTTPCorsApplyConfiguration {
+	for i := range values {
+		b.AllowedMethods = append(b.AllowedMethods, values[i])
+	}
+	return b
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaBridgeProducerSpecApplyConfiguration represents a declarative configuration of the KafkaBridgeProducerSpec type for use
+// with apply.
+type KafkaBridgeProducerSpecApplyConfiguration struct {
+	// Whether the HTTP producer should be enabled or disabled. The default is
+	// enabled (`true`).
+	Enabled *bool `json:"enabled,omitempty"`
+	// The Kafka producer configuration used for producer instances created by
+	// the bridge.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+// KafkaBridgeProducerSpecApplyConfiguration constructs a declarative configuration of the KafkaBridgeProducerSpec type for use with
+// apply.
+func KafkaBridgeProducerSpec() *KafkaBridgeProducerSpecApplyConfiguration {
+	return &KafkaBridgeProducerSpecApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *KafkaBridgeProducerSpecApplyConfiguration) WithEnabled(value bool) *KafkaBridgeProducerSpecApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaBridgeProducerSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaBridgeProducerSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
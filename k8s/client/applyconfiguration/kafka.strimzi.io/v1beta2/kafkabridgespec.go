@@ -0,0 +1,218 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaBridgeSpecApplyConfiguration represents a declarative configuration of the KafkaBridgeSpec type for use
+// with apply.
+type KafkaBridgeSpecApplyConfiguration struct {
+	// The number of pods in the `Deployment`. Defaults to `1`.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The container image used for Kafka Bridge pods. If no image name is
+	// explicitly specified, the image name corresponds to the image specified in
+	// the Cluster Operator configuration. If an image name is not defined in the
+	// Cluster Operator configuration, a default value is used.
+	Image *string `json:"image,omitempty"`
+	// A list of host:port pairs for establishing the initial connection to the
+	// Kafka cluster.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+	// TLS configuration for connecting Kafka Bridge to the cluster.
+	TLS *ClientTLSApplyConfiguration `json:"tls,omitempty"`
+	// Authentication configuration for connecting to the cluster.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+	// The HTTP related configuration.
+	HTTP *KafkaBridgeHTTPConfigApplyConfiguration `json:"http,omitempty"`
+	// Kafka AdminClient related configuration.
+	AdminClient *KafkaBridgeAdminClientSpecApplyConfiguration `json:"adminClient,omitempty"`
+	// Kafka consumer related configuration.
+	Consumer *KafkaBridgeConsumerSpecApplyConfiguration `json:"consumer,omitempty"`
+	// Kafka producer related configuration.
+	Producer *KafkaBridgeProducerSpecApplyConfiguration `json:"producer,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// **Currently not supported** JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// Logging configuration for Kafka Bridge.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+	// Configuration of the node label which will be used as the client.rack
+	// consumer configuration.
+	Rack *RackApplyConfiguration `json:"rack,omitempty"`
+	// Enable the metrics for the Kafka Bridge. Default is false.
+	EnableMetrics *bool `json:"enableMetrics,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// Template for Kafka Bridge resources. The template allows users to specify
+	// how a `Deployment` and `Pod` is generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+	// The configuration of tracing in Kafka Bridge.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+}
+
+// KafkaBridgeSpecApplyConfiguration constructs a declarative configuration of the KafkaBridgeSpec type for use with
+// apply.
+func KafkaBridgeSpec() *KafkaBridgeSpecApplyConfiguration {
+	return &KafkaBridgeSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithReplicas(value int32) *KafkaBridgeSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithImage(value string) *KafkaBridgeSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithBootstrapServers sets the BootstrapServers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapServers field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithBootstrapServers(value string) *KafkaBridgeSpecApplyConfiguration {
+	b.BootstrapServers = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithTLS(value *ClientTLSApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithAuthentication sets the Authentication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authentication field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithAuthentication(value runtime.RawExtension) *KafkaBridgeSpecApplyConfiguration {
+	b.Authentication = &value
+	return b
+}
+
+// WithHTTP sets the HTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithHTTP(value *KafkaBridgeHTTPConfigApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.HTTP = value
+	return b
+}
+
+// WithAdminClient sets the AdminClient field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdminClient field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithAdminClient(value *KafkaBridgeAdminClientSpecApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.AdminClient = value
+	return b
+}
+
+// WithConsumer sets the Consumer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Consumer field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithConsumer(value *KafkaBridgeConsumerSpecApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.Consumer = value
+	return b
+}
+
+// WithProducer sets the Producer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Producer field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithProducer(value *KafkaBridgeProducerSpecApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.Producer = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *KafkaBridgeSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithClientRackInitImage sets the ClientRackInitImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientRackInitImage field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithClientRackInitImage(value string) *KafkaBridgeSpecApplyConfiguration {
+	b.ClientRackInitImage = &value
+	return b
+}
+
+// WithRack sets the Rack field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rack field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithRack(value *RackApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.Rack = value
+	return b
+}
+
+// WithEnableMetrics sets the EnableMetrics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableMetrics field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithEnableMetrics(value bool) *KafkaBridgeSpecApplyConfiguration {
+	b.EnableMetrics = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *KafkaBridgeSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaBridgeSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *KafkaBridgeSpecApplyConfiguration) WithTracing(value runtime.RawExtension) *KafkaBridgeSpecApplyConfiguration {
+	b.Tracing = &value
+	return b
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaBridgeStatusApplyConfiguration represents a declarative configuration of the KafkaBridgeStatus type for use
+// with apply.
+//
+// The status of the Kafka Bridge.
+type KafkaBridgeStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// The URL at which external client applications can access the Kafka
+	// Bridge.
+	URL *string `json:"url,omitempty"`
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// KafkaBridgeStatusApplyConfiguration constructs a declarative configuration of the KafkaBridgeStatus type for use with
+// apply.
+func KafkaBridgeStatus() *KafkaBridgeStatusApplyConfiguration {
+	return &KafkaBridgeStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaBridgeStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaBridgeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaBridgeStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaBridgeStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *KafkaBridgeStatusApplyConfiguration) WithURL(value string) *KafkaBridgeStatusApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaBridgeStatusApplyConfiguration) WithReplicas(value int32) *KafkaBridgeStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *KafkaBridgeStatusApplyConfiguration) WithLabelSelector(value string) *KafkaBridgeStatusApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaClusterSpecApplyConfiguration represents a declarative configuration of the KafkaClusterSpec type for use
+// with apply.
+type KafkaClusterSpecApplyConfiguration struct {
+	// The Kafka broker version. Defaults to the latest version.
+	Version *string `json:"version,omitempty"`
+	// The KRaft metadata version used by the Kafka cluster. This property is
+	// ignored when running in ZooKeeper mode.
+	MetadataVersion *string `json:"metadataVersion,omitempty"`
+	// The number of pods in the cluster. This property is required when node
+	// pools are not used.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The container image used for Kafka pods.
+	Image *string `json:"image,omitempty"`
+	// Configures listeners to provide access to Kafka brokers.
+	Listeners []GenericKafkaListenerApplyConfiguration `json:"listeners,omitempty"`
+	// Kafka broker config properties.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Storage configuration (disk). Cannot be updated. This property is required
+	// when node pools are not used.
+	Storage *StorageApplyConfiguration `json:"storage,omitempty"`
+	// Authorization configuration for Kafka brokers.
+	Authorization *runtime.RawExtension `json:"authorization,omitempty"`
+	// Configuration of the `broker.rack` broker config.
+	Rack *RackApplyConfiguration `json:"rack,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Metrics configuration.
+	MetricsConfig *MetricsConfigApplyConfiguration `json:"metricsConfig,omitempty"`
+	// Logging configuration for Kafka.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// Template for Kafka cluster resources. The template allows users to specify
+	// how the Kubernetes resources are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+	// Configure the tiered storage feature for Kafka brokers.
+	TieredStorage *runtime.RawExtension `json:"tieredStorage,omitempty"`
+	// Quotas plugin configuration for Kafka brokers allows setting quotas for
+	// disk usage, produce/fetch rates, and more.
+	Quotas *runtime.RawExtension `json:"quotas,omitempty"`
+}
+
+// KafkaClusterSpecApplyConfiguration constructs a declarative configuration of the KafkaClusterSpec type for use with
+// apply.
+func KafkaClusterSpec() *KafkaClusterSpecApplyConfiguration {
+	return &KafkaClusterSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithVersion(value string) *KafkaClusterSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithMetadataVersion sets the MetadataVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetadataVersion field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithMetadataVersion(value string) *KafkaClusterSpecApplyConfiguration {
+	b.MetadataVersion = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithReplicas(value int32) *KafkaClusterSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithImage(value string) *KafkaClusterSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *KafkaClusterSpecApplyConfiguration) WithListeners(values ...*GenericKafkaListenerApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaClusterSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithStorage(value *StorageApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithAuthorization sets the Authorization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authorization field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithAuthorization(value runtime.RawExtension) *KafkaClusterSpecApplyConfiguration {
+	b.Authorization = &value
+	return b
+}
+
+// WithRack sets the Rack field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rack field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithRack(value *RackApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.Rack = value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *KafkaClusterSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithMetricsConfig sets the MetricsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsConfig field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithMetricsConfig(value *MetricsConfigApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.MetricsConfig = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *KafkaClusterSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaClusterSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithTieredStorage sets the TieredStorage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TieredStorage field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithTieredStorage(value runtime.RawExtension) *KafkaClusterSpecApplyConfiguration {
+	b.TieredStorage = &value
+	return b
+}
+
+// WithQuotas sets the Quotas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quotas field is set to the value of the last call.
+func (b *KafkaClusterSpecApplyConfiguration) WithQuotas(value runtime.RawExtension) *KafkaClusterSpecApplyConfiguration {
+	b.Quotas = &value
+	return b
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaConnectorSpecApplyConfiguration represents a declarative configuration of the KafkaConnectorSpec type for use
+// with apply.
+type KafkaConnectorSpecApplyConfiguration struct {
+	// The Class for the Kafka Connector.
+	Class *string `json:"class,omitempty"`
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+	// Automatic restart of connector and tasks configuration.
+	AutoRestart *AutoRestartApplyConfiguration `json:"autoRestart,omitempty"`
+	// The Kafka Connector configuration. The following properties cannot be set:
+	// name, connector.class, tasks.max.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// The state the connector should be in. Defaults to running.
+	State *string `json:"state,omitempty"`
+	// Configuration for listing offsets.
+	ListOffsets *runtime.RawExtension `json:"listOffsets,omitempty"`
+	// Configuration for altering offsets.
+	AlterOffsets *runtime.RawExtension `json:"alterOffsets,omitempty"`
+}
+
+// KafkaConnectorSpecApplyConfiguration constructs a declarative configuration of the KafkaConnectorSpec type for use with
+// apply.
+func KafkaConnectorSpec() *KafkaConnectorSpecApplyConfiguration {
+	return &KafkaConnectorSpecApplyConfiguration{}
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithClass(value string) *KafkaConnectorSpecApplyConfiguration {
+	b.Class = &value
+	return b
+}
+
+// WithTasksMax sets the TasksMax field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TasksMax field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithTasksMax(value int32) *KafkaConnectorSpecApplyConfiguration {
+	b.TasksMax = &value
+	return b
+}
+
+// WithAutoRestart sets the AutoRestart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRestart field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithAutoRestart(value *AutoRestartApplyConfiguration) *KafkaConnectorSpecApplyConfiguration {
+	b.AutoRestart = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaConnectorSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithState(value string) *KafkaConnectorSpecApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithListOffsets sets the ListOffsets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ListOffsets field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithListOffsets(value runtime.RawExtension) *KafkaConnectorSpecApplyConfiguration {
+	b.ListOffsets = &value
+	return b
+}
+
+// WithAlterOffsets sets the AlterOffsets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlterOffsets field is set to the value of the last call.
+func (b *KafkaConnectorSpecApplyConfiguration) WithAlterOffsets(value runtime.RawExtension) *KafkaConnectorSpecApplyConfiguration {
+	b.AlterOffsets = &value
+	return b
+}
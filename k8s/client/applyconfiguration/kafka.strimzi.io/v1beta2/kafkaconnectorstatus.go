@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaConnectorStatusApplyConfiguration represents a declarative configuration of the KafkaConnectorStatus type for use
+// with apply.
+//
+// The status of the Kafka Connector.
+type KafkaConnectorStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// The auto restart status.
+	AutoRestart *AutoRestartStatusApplyConfiguration `json:"autoRestart,omitempty"`
+	// The connector status, as reported by the Kafka Connect REST API.
+	ConnectorStatus *ConnectorStatusApplyConfiguration `json:"connectorStatus,omitempty"`
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+	// The list of topics used by the Kafka Connector.
+	Topics []string `json:"topics,omitempty"`
+}
+
+// KafkaConnectorStatusApplyConfiguration constructs a declarative configuration of the KafkaConnectorStatus type for use with
+// apply.
+func KafkaConnectorStatus() *KafkaConnectorStatusApplyConfiguration {
+	return &KafkaConnectorStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaConnectorStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaConnectorStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaConnectorStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaConnectorStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithAutoRestart sets the AutoRestart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRestart field is set to the value of the last call.
+func (b *KafkaConnectorStatusApplyConfiguration) WithAutoRestart(value *AutoRestartStatusApplyConfiguration) *KafkaConnectorStatusApplyConfiguration {
+	b.AutoRestart = value
+	return b
+}
+
+// WithConnectorStatus sets the ConnectorStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectorStatus field is set to the value of the last call.
+func (b *KafkaConnectorStatusApplyConfiguration) WithConnectorStatus(value *ConnectorStatusApplyConfiguration) *KafkaConnectorStatusApplyConfiguration {
+	b.ConnectorStatus = value
+	return b
+}
+
+// WithTasksMax sets the TasksMax field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TasksMax field is set to the value of the last call.
+func (b *KafkaConnectorStatusApplyConfiguration) WithTasksMax(value int32) *KafkaConnectorStatusApplyConfiguration {
+	b.TasksMax = &value
+	return b
+}
+
+// WithTopics adds the given value to the Topics field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Topics field.
+func (b *KafkaConnectorStatusApplyConfiguration) WithTopics(values ...string) *KafkaConnectorStatusApplyConfiguration {
+	for i := range values {
+		b.Topics = append(b.Topics, values[i])
+	}
+	return b
+}
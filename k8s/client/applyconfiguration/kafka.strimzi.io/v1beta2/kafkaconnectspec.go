@@ -0,0 +1,233 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaConnectSpecApplyConfiguration represents a declarative configuration of the KafkaConnectSpec type for use
+// with apply.
+type KafkaConnectSpecApplyConfiguration struct {
+	// The Kafka Connect version. Defaults to the latest version. Consult the user
+	// documentation to understand the process required to upgrade or downgrade
+	// the version.
+	Version *string `json:"version,omitempty"`
+	// The number of pods in the Kafka Connect group. Defaults to `3`.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The container image used for Kafka Connect pods. If no image name is
+	// explicitly specified, it is determined based on the `spec.version`
+	// configuration. The image names are specifically mapped to corresponding
+	// versions in the Cluster Operator configuration.
+	Image *string `json:"image,omitempty"`
+	// Bootstrap servers to connect to. This should be given as a comma separated
+	// list of _<hostname>_:_<port>_ pairs.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+	// TLS configuration.
+	TLS *ClientTLSApplyConfiguration `json:"tls,omitempty"`
+	// Authentication configuration for Kafka Connect.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+	// The Kafka Connect configuration.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// The maximum limits for CPU and memory resources and the requested initial
+	// resources.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// JMX Options.
+	JmxOptions *runtime.RawExtension `json:"jmxOptions,omitempty"`
+	// Logging configuration for Kafka Connect.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+	// Configuration of the node label which will be used as the `client.rack`
+	// consumer configuration.
+	Rack *RackApplyConfiguration `json:"rack,omitempty"`
+	// Metrics configuration.
+	MetricsConfig *MetricsConfigApplyConfiguration `json:"metricsConfig,omitempty"`
+	// The configuration of tracing in Kafka Connect.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+	// Template for Kafka Connect and Kafka Mirror Maker 2 resources. The template
+	// allows users to specify how the `Pods`, `Service`, and other services are
+	// generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+	// Pass data from Secrets or ConfigMaps to the Kafka Connect pods and use them
+	// to configure connectors.
+	ExternalConfiguration *ExternalConfigurationApplyConfiguration `json:"externalConfiguration,omitempty"`
+	// Configures how the Connect container image should be built. Optional.
+	Build *BuildApplyConfiguration `json:"build,omitempty"`
+}
+
+// KafkaConnectSpecApplyConfiguration constructs a declarative configuration of the KafkaConnectSpec type for use with
+// apply.
+func KafkaConnectSpec() *KafkaConnectSpecApplyConfiguration {
+	return &KafkaConnectSpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithVersion(value string) *KafkaConnectSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithReplicas(value int32) *KafkaConnectSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithImage(value string) *KafkaConnectSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithBootstrapServers sets the BootstrapServers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapServers field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithBootstrapServers(value string) *KafkaConnectSpecApplyConfiguration {
+	b.BootstrapServers = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithTLS(value *ClientTLSApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithAuthentication sets the Authentication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authentication field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithAuthentication(value runtime.RawExtension) *KafkaConnectSpecApplyConfiguration {
+	b.Authentication = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaConnectSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *KafkaConnectSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithJmxOptions sets the JmxOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JmxOptions field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithJmxOptions(value runtime.RawExtension) *KafkaConnectSpecApplyConfiguration {
+	b.JmxOptions = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithClientRackInitImage sets the ClientRackInitImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientRackInitImage field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithClientRackInitImage(value string) *KafkaConnectSpecApplyConfiguration {
+	b.ClientRackInitImage = &value
+	return b
+}
+
+// WithRack sets the Rack field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rack field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithRack(value *RackApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.Rack = value
+	return b
+}
+
+// WithMetricsConfig sets the MetricsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsConfig field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithMetricsConfig(value *MetricsConfigApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.MetricsConfig = value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithTracing(value runtime.RawExtension) *KafkaConnectSpecApplyConfiguration {
+	b.Tracing = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaConnectSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithExternalConfiguration sets the ExternalConfiguration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalConfiguration field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithExternalConfiguration(value *ExternalConfigurationApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.ExternalConfiguration = value
+	return b
+}
+
+// WithBuild sets the Build field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Build field is set to the value of the last call.
+func (b *KafkaConnectSpecApplyConfiguration) WithBuild(value *BuildApplyConfiguration) *KafkaConnectSpecApplyConfiguration {
+	b.Build = value
+	return b
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaConnectStatusApplyConfiguration represents a declarative configuration of the KafkaConnectStatus type for use
+// with apply.
+//
+// The status of the Kafka Connect cluster.
+type KafkaConnectStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// The URL of the REST API endpoint for managing and monitoring Kafka Connect
+	// connectors.
+	URL *string `json:"url,omitempty"`
+	// The list of connector plugins available in this Kafka Connect deployment.
+	ConnectorPlugins []ConnectorPluginApplyConfiguration `json:"connectorPlugins,omitempty"`
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// KafkaConnectStatusApplyConfiguration constructs a declarative configuration of the KafkaConnectStatus type for use with
+// apply.
+func KafkaConnectStatus() *KafkaConnectStatusApplyConfiguration {
+	return &KafkaConnectStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaConnectStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaConnectStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaConnectStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaConnectStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *KafkaConnectStatusApplyConfiguration) WithURL(value string) *KafkaConnectStatusApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithConnectorPlugins adds the given value to the ConnectorPlugins field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ConnectorPlugins field.
+func (b *KafkaConnectStatusApplyConfiguration) WithConnectorPlugins(values ...*ConnectorPluginApplyConfiguration) *KafkaConnectStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConnectorPlugins")
+		}
+		b.ConnectorPlugins = append(b.ConnectorPlugins, *values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaConnectStatusApplyConfiguration) WithReplicas(value int32) *KafkaConnectStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *KafkaConnectStatusApplyConfiguration) WithLabelSelector(value string) *KafkaConnectStatusApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
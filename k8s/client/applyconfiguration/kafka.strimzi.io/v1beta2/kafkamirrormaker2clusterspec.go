@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaMirrorMaker2ClusterSpecApplyConfiguration represents a declarative configuration of the KafkaMirrorMaker2ClusterSpec type for use
+// with apply.
+type KafkaMirrorMaker2ClusterSpecApplyConfiguration struct {
+	// Alias used to reference the Kafka cluster.
+	Alias *string `json:"alias,omitempty"`
+	// A comma-separated list of `host:port` pairs for establishing the connection
+	// to the Kafka cluster.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+	// TLS configuration for connecting MirrorMaker 2 connectors to a cluster.
+	TLS *ClientTLSApplyConfiguration `json:"tls,omitempty"`
+	// Authentication configuration for connecting to the cluster.
+	Authentication *runtime.RawExtension `json:"authentication,omitempty"`
+	// The MirrorMaker 2 cluster config. Properties with the following prefixes
+	// cannot be set: ssl., sasl., security., listeners, plugin.path, rest.,
+	// bootstrap.servers, consumer.interceptor.classes,
+	// producer.interceptor.classes.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
+
+// KafkaMirrorMaker2ClusterSpecApplyConfiguration constructs a declarative configuration of the KafkaMirrorMaker2ClusterSpec type for use with
+// apply.
+func KafkaMirrorMaker2ClusterSpec() *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	return &KafkaMirrorMaker2ClusterSpecApplyConfiguration{}
+}
+
+// WithAlias sets the Alias field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Alias field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ClusterSpecApplyConfiguration) WithAlias(value string) *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	b.Alias = &value
+	return b
+}
+
+// WithBootstrapServers sets the BootstrapServers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapServers field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ClusterSpecApplyConfiguration) WithBootstrapServers(value string) *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	b.BootstrapServers = &value
+	return b
+}
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ClusterSpecApplyConfiguration) WithTLS(value *ClientTLSApplyConfiguration) *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithAuthentication sets the Authentication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authentication field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ClusterSpecApplyConfiguration) WithAuthentication(value runtime.RawExtension) *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	b.Authentication = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ClusterSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaMirrorMaker2ClusterSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
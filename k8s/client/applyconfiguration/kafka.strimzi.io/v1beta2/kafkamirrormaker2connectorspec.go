@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaMirrorMaker2ConnectorSpecApplyConfiguration represents a declarative configuration of the KafkaMirrorMaker2ConnectorSpec type for use
+// with apply.
+type KafkaMirrorMaker2ConnectorSpecApplyConfiguration struct {
+	// The maximum number of tasks for the Kafka Connector.
+	TasksMax *int32 `json:"tasksMax,omitempty"`
+	// The Kafka Connector configuration. The following properties cannot be set:
+	// name, connector.class, tasks.max.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Automatic restart of connector and tasks configuration.
+	AutoRestart *AutoRestartApplyConfiguration `json:"autoRestart,omitempty"`
+	// The state the connector should be in. Defaults to running.
+	State *string `json:"state,omitempty"`
+	// Configuration for listing offsets.
+	ListOffsets *runtime.RawExtension `json:"listOffsets,omitempty"`
+	// Configuration for altering offsets.
+	AlterOffsets *runtime.RawExtension `json:"alterOffsets,omitempty"`
+}
+
+// KafkaMirrorMaker2ConnectorSpecApplyConfiguration constructs a declarative configuration of the KafkaMirrorMaker2ConnectorSpec type for use with
+// apply.
+func KafkaMirrorMaker2ConnectorSpec() *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	return &KafkaMirrorMaker2ConnectorSpecApplyConfiguration{}
+}
+
+// WithTasksMax sets the TasksMax field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TasksMax field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithTasksMax(value int32) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.TasksMax = &value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithAutoRestart sets the AutoRestart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRestart field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithAutoRestart(value *AutoRestartApplyConfiguration) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.AutoRestart = value
+	return b
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithState(value string) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithListOffsets sets the ListOffsets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ListOffsets field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithListOffsets(value runtime.RawExtension) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.ListOffsets = &value
+	return b
+}
+
+// WithAlterOffsets sets the AlterOffsets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlterOffsets field is set to the value of the last call.
+func (b *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) WithAlterOffsets(value runtime.RawExtension) *KafkaMirrorMaker2ConnectorSpecApplyConfiguration {
+	b.AlterOffsets = &value
+	return b
+}
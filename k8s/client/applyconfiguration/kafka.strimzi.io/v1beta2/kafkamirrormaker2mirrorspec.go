@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaMirrorMaker2MirrorSpecApplyConfiguration represents a declarative configuration of the KafkaMirrorMaker2MirrorSpec type for use
+// with apply.
+type KafkaMirrorMaker2MirrorSpecApplyConfiguration struct {
+	// The alias of the source cluster used by the Kafka MirrorMaker 2
+	// connectors. The alias must match a cluster in the list at
+	// `spec.clusters`.
+	SourceCluster *string `json:"sourceCluster,omitempty"`
+	// The alias of the target cluster used by the Kafka MirrorMaker 2
+	// connectors. The alias must match a cluster in the list at
+	// `spec.clusters`.
+	TargetCluster *string `json:"targetCluster,omitempty"`
+	// The specification of the Kafka MirrorMaker 2 source connector.
+	SourceConnector *KafkaMirrorMaker2ConnectorSpecApplyConfiguration `json:"sourceConnector,omitempty"`
+	// The specification of the Kafka MirrorMaker 2 heartbeat connector.
+	HeartbeatConnector *KafkaMirrorMaker2ConnectorSpecApplyConfiguration `json:"heartbeatConnector,omitempty"`
+	// The specification of the Kafka MirrorMaker 2 checkpoint connector.
+	CheckpointConnector *KafkaMirrorMaker2ConnectorSpecApplyConfiguration `json:"checkpointConnector,omitempty"`
+	// A regular expression matching the topics to be mirrored, for example,
+	// "topic1\|topic2\|topic3". Comma-separated lists are also supported.
+	TopicsPattern *string `json:"topicsPattern,omitempty"`
+	// A regular expression matching the topics to exclude from mirroring.
+	// Comma-separated lists are also supported.
+	TopicsExcludePattern *string `json:"topicsExcludePattern,omitempty"`
+	// A regular expression matching the consumer groups to be mirrored.
+	// Comma-separated lists are also supported.
+	GroupsPattern *string `json:"groupsPattern,omitempty"`
+	// A regular expression matching the consumer groups to exclude from
+	// mirroring. Comma-separated lists are also supported.
+	GroupsExcludePattern *string `json:"groupsExcludePattern,omitempty"`
+}
+
+// KafkaMirrorMaker2MirrorSpecApplyConfiguration constructs a declarative configuration of the KafkaMirrorMaker2MirrorSpec type for use with
+// apply.
+func KafkaMirrorMaker2MirrorSpec() *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	return &KafkaMirrorMaker2MirrorSpecApplyConfiguration{}
+}
+
+// WithSourceCluster sets the SourceCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceCluster field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithSourceCluster(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.SourceCluster = &value
+	return b
+}
+
+// WithTargetCluster sets the TargetCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetCluster field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithTargetCluster(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.TargetCluster = &value
+	return b
+}
+
+// WithSourceConnector sets the SourceConnector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceConnector field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithSourceConnector(value *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.SourceConnector = value
+	return b
+}
+
+// WithHeartbeatConnector sets the HeartbeatConnector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeartbeatConnector field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithHeartbeatConnector(value *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.HeartbeatConnector = value
+	return b
+}
+
+// WithCheckpointConnector sets the CheckpointConnector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CheckpointConnector field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithCheckpointConnector(value *KafkaMirrorMaker2ConnectorSpecApplyConfiguration) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.CheckpointConnector = value
+	return b
+}
+
+// WithTopicsPattern sets the TopicsPattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopicsPattern field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithTopicsPattern(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.TopicsPattern = &value
+	return b
+}
+
+// WithTopicsExcludePattern sets the TopicsExcludePattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopicsExcludePattern field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithTopicsExcludePattern(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.TopicsExcludePattern = &value
+	return b
+}
+
+// WithGroupsPattern sets the GroupsPattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupsPattern field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithGroupsPattern(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.GroupsPattern = &value
+	return b
+}
+
+// WithGroupsExcludePattern sets the GroupsExcludePattern field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GroupsExcludePattern field is set to the value of the last call.
+func (b *KafkaMirrorMaker2MirrorSpecApplyConfiguration) WithGroupsExcludePattern(value string) *KafkaMirrorMaker2MirrorSpecApplyConfiguration {
+	b.GroupsExcludePattern = &value
+	return b
+}
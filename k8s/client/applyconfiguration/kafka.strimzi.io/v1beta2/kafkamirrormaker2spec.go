@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaMirrorMaker2SpecApplyConfiguration represents a declarative configuration of the KafkaMirrorMaker2Spec type for use
+// with apply.
+type KafkaMirrorMaker2SpecApplyConfiguration struct {
+	// The Kafka Connect version. Defaults to the latest version. Consult the user
+	// documentation to understand the process required to upgrade or downgrade
+	// the version.
+	Version *string `json:"version,omitempty"`
+	// The number of pods in the Kafka Connect group. Defaults to `3`.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The container image used for Kafka Connect pods. If no image name is
+	// explicitly specified, it is determined based on the `spec.version`
+	// configuration. The image names are specifically mapped to corresponding
+	// versions in the Cluster Operator configuration.
+	Image *string `json:"image,omitempty"`
+	// The cluster alias used for Kafka Connect. The value must match the alias
+	// of the *target* Kafka cluster as specified in the `spec.clusters`
+	// configuration. The target Kafka cluster is used by the underlying Kafka
+	// Connect framework for its internal topics.
+	ConnectCluster *string `json:"connectCluster,omitempty"`
+	// Kafka clusters for mirroring.
+	Clusters []KafkaMirrorMaker2ClusterSpecApplyConfiguration `json:"clusters,omitempty"`
+	// Configuration of the MirrorMaker 2 connectors.
+	Mirrors []KafkaMirrorMaker2MirrorSpecApplyConfiguration `json:"mirrors,omitempty"`
+	// The maximum limits for CPU and memory resources and the requested initial
+	// resources.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// JMX Options.
+	JmxOptions *runtime.RawExtension `json:"jmxOptions,omitempty"`
+	// Logging configuration for Kafka Connect.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// The image of the init container used for initializing the `client.rack`.
+	ClientRackInitImage *string `json:"clientRackInitImage,omitempty"`
+	// Configuration of the node label which will be used as the `client.rack`
+	// consumer configuration.
+	Rack *RackApplyConfiguration `json:"rack,omitempty"`
+	// Metrics configuration.
+	MetricsConfig *MetricsConfigApplyConfiguration `json:"metricsConfig,omitempty"`
+	// The configuration of tracing in Kafka Connect.
+	Tracing *runtime.RawExtension `json:"tracing,omitempty"`
+	// Template for Kafka Connect and Kafka Mirror Maker 2 resources. The template
+	// allows users to specify how the `Pods`, `Service`, and other services are
+	// generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+	// Pass data from Secrets or ConfigMaps to the Kafka Connect pods and use them
+	// to configure connectors.
+	ExternalConfiguration *ExternalConfigurationApplyConfiguration `json:"externalConfiguration,omitempty"`
+}
+
+// KafkaMirrorMaker2SpecApplyConfiguration constructs a declarative configuration of the KafkaMirrorMaker2Spec type for use with
+// apply.
+func KafkaMirrorMaker2Spec() *KafkaMirrorMaker2SpecApplyConfiguration {
+	return &KafkaMirrorMaker2SpecApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithVersion(value string) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithReplicas(value int32) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithImage(value string) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithConnectCluster sets the ConnectCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectCluster field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithConnectCluster(value string) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.ConnectCluster = &value
+	return b
+}
+
+// WithClusters adds the given value to the Clusters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clusters field.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithClusters(values ...*KafkaMirrorMaker2ClusterSpecApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClusters")
+		}
+		b.Clusters = append(b.Clusters, *values[i])
+	}
+	return b
+}
+
+// WithMirrors adds the given value to the Mirrors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Mirrors field.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithMirrors(values ...*KafkaMirrorMaker2MirrorSpecApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMirrors")
+		}
+		b.Mirrors = append(b.Mirrors, *values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithJmxOptions sets the JmxOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JmxOptions field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithJmxOptions(value runtime.RawExtension) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.JmxOptions = &value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithClientRackInitImage sets the ClientRackInitImage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientRackInitImage field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithClientRackInitImage(value string) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.ClientRackInitImage = &value
+	return b
+}
+
+// WithRack sets the Rack field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rack field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithRack(value *RackApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Rack = value
+	return b
+}
+
+// WithMetricsConfig sets the MetricsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsConfig field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithMetricsConfig(value *MetricsConfigApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.MetricsConfig = value
+	return b
+}
+
+// WithTracing sets the Tracing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tracing field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithTracing(value runtime.RawExtension) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Tracing = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
+
+// WithExternalConfiguration sets the ExternalConfiguration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalConfiguration field is set to the value of the last call.
+func (b *KafkaMirrorMaker2SpecApplyConfiguration) WithExternalConfiguration(value *ExternalConfigurationApplyConfiguration) *KafkaMirrorMaker2SpecApplyConfiguration {
+	b.ExternalConfiguration = value
+	return b
+}
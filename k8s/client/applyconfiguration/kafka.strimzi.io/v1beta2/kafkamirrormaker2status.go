@@ -0,0 +1,121 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaMirrorMaker2StatusApplyConfiguration represents a declarative configuration of the KafkaMirrorMaker2Status type for use
+// with apply.
+//
+// The status of the Kafka MirrorMaker 2 cluster.
+type KafkaMirrorMaker2StatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// The URL of the REST API endpoint for managing and monitoring Kafka Connect
+	// connectors.
+	URL *string `json:"url,omitempty"`
+	// List of MirrorMaker 2 connector statuses, as reported by the Kafka Connect
+	// REST API.
+	Connectors []ConnectorStatusApplyConfiguration `json:"connectors,omitempty"`
+	// List of MirrorMaker 2 connector auto restart statuses.
+	AutoRestartStatuses []AutoRestartStatusApplyConfiguration `json:"autoRestartStatuses,omitempty"`
+	// The list of connector plugins available in this Kafka Connect deployment.
+	ConnectorPlugins []ConnectorPluginApplyConfiguration `json:"connectorPlugins,omitempty"`
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// KafkaMirrorMaker2StatusApplyConfiguration constructs a declarative configuration of the KafkaMirrorMaker2Status type for use with
+// apply.
+func KafkaMirrorMaker2Status() *KafkaMirrorMaker2StatusApplyConfiguration {
+	return &KafkaMirrorMaker2StatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaMirrorMaker2StatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaMirrorMaker2StatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithURL(value string) *KafkaMirrorMaker2StatusApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithConnectors adds the given value to the Connectors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Connectors field.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithConnectors(values ...*ConnectorStatusApplyConfiguration) *KafkaMirrorMaker2StatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConnectors")
+		}
+		b.Connectors = append(b.Connectors, *values[i])
+	}
+	return b
+}
+
+// WithAutoRestartStatuses adds the given value to the AutoRestartStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AutoRestartStatuses field.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithAutoRestartStatuses(values ...*AutoRestartStatusApplyConfiguration) *KafkaMirrorMaker2StatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAutoRestartStatuses")
+		}
+		b.AutoRestartStatuses = append(b.AutoRestartStatuses, *values[i])
+	}
+	return b
+}
+
+// WithConnectorPlugins adds the given value to the ConnectorPlugins field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ConnectorPlugins field.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithConnectorPlugins(values ...*ConnectorPluginApplyConfiguration) *KafkaMirrorMaker2StatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConnectorPlugins")
+		}
+		b.ConnectorPlugins = append(b.ConnectorPlugins, *values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithReplicas(value int32) *KafkaMirrorMaker2StatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *KafkaMirrorMaker2StatusApplyConfiguration) WithLabelSelector(value string) *KafkaMirrorMaker2StatusApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
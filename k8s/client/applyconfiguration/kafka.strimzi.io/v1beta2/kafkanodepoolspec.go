@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaNodePoolSpecApplyConfiguration represents a declarative configuration of the KafkaNodePoolSpec type for use
+// with apply.
+type KafkaNodePoolSpecApplyConfiguration struct {
+	// The number of pods in the pool.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Storage configuration (disk). Cannot be updated.
+	Storage *StorageApplyConfiguration `json:"storage,omitempty"`
+	// The roles that the nodes in this pool will have when KRaft mode is
+	// enabled. Supported values are 'broker' and 'controller'. This field is
+	// required. When KRaft mode is disabled, the only allowed value if `broker`.
+	Roles []string `json:"roles,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// Template for pool resources. The template allows users to specify how the
+	// resources belonging to this pool are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// KafkaNodePoolSpecApplyConfiguration constructs a declarative configuration of the KafkaNodePoolSpec type for use with
+// apply.
+func KafkaNodePoolSpec() *KafkaNodePoolSpecApplyConfiguration {
+	return &KafkaNodePoolSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithReplicas(value int32) *KafkaNodePoolSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithStorage(value *StorageApplyConfiguration) *KafkaNodePoolSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithRoles adds the given value to the Roles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Roles field.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithRoles(values ...string) *KafkaNodePoolSpecApplyConfiguration {
+	for i := range values {
+		b.Roles = append(b.Roles, values[i])
+	}
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *KafkaNodePoolSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *KafkaNodePoolSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaNodePoolSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaNodePoolSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
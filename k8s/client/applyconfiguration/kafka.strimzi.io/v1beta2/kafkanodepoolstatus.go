@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaNodePoolStatusApplyConfiguration represents a declarative configuration of the KafkaNodePoolStatus type for use
+// with apply.
+//
+// The status of the KafkaNodePool.
+type KafkaNodePoolStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// Node IDs used by Kafka nodes in this pool.
+	NodeIDs []int32 `json:"nodeIds,omitempty"`
+	// Kafka cluster ID.
+	ClusterID *string `json:"clusterId,omitempty"`
+	// The roles currently assigned to this pool.
+	Roles []string `json:"roles,omitempty"`
+	// The current number of pods being used to provide this resource.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods providing this resource.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// KafkaNodePoolStatusApplyConfiguration constructs a declarative configuration of the KafkaNodePoolStatus type for use with
+// apply.
+func KafkaNodePoolStatus() *KafkaNodePoolStatusApplyConfiguration {
+	return &KafkaNodePoolStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaNodePoolStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaNodePoolStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithNodeIDs adds the given value to the NodeIDs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeIDs field.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithNodeIDs(values ...int32) *KafkaNodePoolStatusApplyConfiguration {
+	for i := range values {
+		b.NodeIDs = append(b.NodeIDs, values[i])
+	}
+	return b
+}
+
+// WithClusterID sets the ClusterID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterID field is set to the value of the last call.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithClusterID(value string) *KafkaNodePoolStatusApplyConfiguration {
+	b.ClusterID = &value
+	return b
+}
+
+// WithRoles adds the given value to the Roles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Roles field.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithRoles(values ...string) *KafkaNodePoolStatusApplyConfiguration {
+	for i := range values {
+		b.Roles = append(b.Roles, values[i])
+	}
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithReplicas(value int32) *KafkaNodePoolStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *KafkaNodePoolStatusApplyConfiguration) WithLabelSelector(value string) *KafkaNodePoolStatusApplyConfiguration {
+	b.LabelSelector = &value
+	return b
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaRebalanceSpecApplyConfiguration represents a declarative configuration of the KafkaRebalanceSpec type for use
+// with apply.
+type KafkaRebalanceSpecApplyConfiguration struct {
+	// Mode to run the rebalancing. The supported modes are `full`,
+	// `add-brokers`, `remove-brokers`, `remove-disks`. If not specified, the
+	// `full` mode is used by default.
+	Mode *string `json:"mode,omitempty"`
+	// The list of newly added brokers in case of scaling up or the ones to be
+	// removed in case of scaling down to use for rebalancing. This list can be
+	// used only with rebalancing mode `add-brokers` and `removed-brokers`. It
+	// is ignored with `full` mode.
+	Brokers []int32 `json:"brokers,omitempty"`
+	// A list of goals, ordered by decreasing priority, to use for generating and
+	// executing the rebalance proposal. The supported goals are available at
+	// https://github.com/linkedin/cruise-control#goals. If an empty goals list
+	// is provided, the goals declared in the default.goals Cruise Control
+	// configuration parameter are used.
+	Goals []string `json:"goals,omitempty"`
+	// Whether to allow the hard goals specified in the Kafka CR to be skipped in
+	// optimization proposal generation. This can be useful when some of those
+	// hard goals are preventing a balance solution being found. Default is
+	// false.
+	SkipHardGoalCheck *bool `json:"skipHardGoalCheck,omitempty"`
+	// Enables intra-broker disk balancing, which balances disk space
+	// utilization between disks on the same broker. Only applies to Kafka
+	// deployments that use JBOD storage with multiple disks. When enabled,
+	// inter-broker balancing is disabled. Default is false.
+	RebalanceDisk *bool `json:"rebalanceDisk,omitempty"`
+	// A regular expression where any matching topics will be excluded from the
+	// calculation of optimization proposals. This expression will be parsed by
+	// the java.util.regex.Pattern class; for more information on the supported
+	// format consult the documentation for that class.
+	ExcludedTopics *string `json:"excludedTopics,omitempty"`
+	// The upper bound of ongoing partition replica movements going into/out of
+	// each broker. Default is 5.
+	ConcurrentPartitionMovementsPerBroker *int32 `json:"concurrentPartitionMovementsPerBroker,omitempty"`
+	// The upper bound of ongoing partition replica movements between disks
+	// within each broker. Default is 2.
+	ConcurrentIntraBrokerPartitionMovements *int32 `json:"concurrentIntraBrokerPartitionMovements,omitempty"`
+	// The upper bound of ongoing partition leadership movements. Default is
+	// 1000.
+	ConcurrentLeaderMovements *int32 `json:"concurrentLeaderMovements,omitempty"`
+	// The upper bound, in bytes per second, on the bandwidth used to move
+	// replicas. There is no limit by default.
+	ReplicationThrottle *int64 `json:"replicationThrottle,omitempty"`
+	// A list of strategy class names used to determine the execution order for
+	// the replica movements in the generated optimization proposal. By default
+	// BaseReplicaMovementStrategy is used, which will execute the replica
+	// movements in the order that they were generated.
+	ReplicaMovementStrategies []string `json:"replicaMovementStrategies,omitempty"`
+	// List of brokers and their corresponding volumes from which replicas need
+	// to be moved.
+	MoveReplicasOffVolumes []BrokerAndVolumeIDsApplyConfiguration `json:"moveReplicasOffVolumes,omitempty"`
+}
+
+// KafkaRebalanceSpecApplyConfiguration constructs a declarative configuration of the KafkaRebalanceSpec type for use with
+// apply.
+func KafkaRebalanceSpec() *KafkaRebalanceSpecApplyConfiguration {
+	return &KafkaRebalanceSpecApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithMode(value string) *KafkaRebalanceSpecApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithBrokers adds the given value to the Brokers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Brokers field.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithBrokers(values ...int32) *KafkaRebalanceSpecApplyConfiguration {
+	for i := range values {
+		b.Brokers = append(b.Brokers, values[i])
+	}
+	return b
+}
+
+// WithGoals adds the given value to the Goals field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Goals field.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithGoals(values ...string) *KafkaRebalanceSpecApplyConfiguration {
+	for i := range values {
+		b.Goals = append(b.Goals, values[i])
+	}
+	return b
+}
+
+// WithSkipHardGoalCheck sets the SkipHardGoalCheck field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SkipHardGoalCheck field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithSkipHardGoalCheck(value bool) *KafkaRebalanceSpecApplyConfiguration {
+	b.SkipHardGoalCheck = &value
+	return b
+}
+
+// WithRebalanceDisk sets the RebalanceDisk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RebalanceDisk field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithRebalanceDisk(value bool) *KafkaRebalanceSpecApplyConfiguration {
+	b.RebalanceDisk = &value
+	return b
+}
+
+// WithExcludedTopics sets the ExcludedTopics field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExcludedTopics field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithExcludedTopics(value string) *KafkaRebalanceSpecApplyConfiguration {
+	b.ExcludedTopics = &value
+	return b
+}
+
+// WithConcurrentPartitionMovementsPerBroker sets the ConcurrentPartitionMovementsPerBroker field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConcurrentPartitionMovementsPerBroker field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithConcurrentPartitionMovementsPerBroker(value int32) *KafkaRebalanceSpecApplyConfiguration {
+	b.ConcurrentPartitionMovementsPerBroker = &value
+	return b
+}
+
+// WithConcurrentIntraBrokerPartitionMovements sets the ConcurrentIntraBrokerPartitionMovements field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConcurrentIntraBrokerPartitionMovements field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithConcurrentIntraBrokerPartitionMovements(value int32) *KafkaRebalanceSpecApplyConfiguration {
+	b.ConcurrentIntraBrokerPartitionMovements = &value
+	return b
+}
+
+// WithConcurrentLeaderMovements sets the ConcurrentLeaderMovements field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConcurrentLeaderMovements field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithConcurrentLeaderMovements(value int32) *KafkaRebalanceSpecApplyConfiguration {
+	b.ConcurrentLeaderMovements = &value
+	return b
+}
+
+// WithReplicationThrottle sets the ReplicationThrottle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReplicationThrottle field is set to the value of the last call.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithReplicationThrottle(value int64) *KafkaRebalanceSpecApplyConfiguration {
+	b.ReplicationThrottle = &value
+	return b
+}
+
+// WithReplicaMovementStrategies adds the given value to the ReplicaMovementStrategies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReplicaMovementStrategies field.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithReplicaMovementStrategies(values ...string) *KafkaRebalanceSpecApplyConfiguration {
+	for i := range values {
+		b.ReplicaMovementStrategies = append(b.ReplicaMovementStrategies, values[i])
+	}
+	return b
+}
+
+// WithMoveReplicasOffVolumes adds the given value to the MoveReplicasOffVolumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MoveReplicasOffVolumes field.
+func (b *KafkaRebalanceSpecApplyConfiguration) WithMoveReplicasOffVolumes(values ...*BrokerAndVolumeIDsApplyConfiguration) *KafkaRebalanceSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMoveReplicasOffVolumes")
+		}
+		b.MoveReplicasOffVolumes = append(b.MoveReplicasOffVolumes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaRebalanceStatusApplyConfiguration represents a declarative configuration of the KafkaRebalanceStatus type for use
+// with apply.
+//
+// The status of the Kafka rebalance.
+type KafkaRebalanceStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// The session identifier for requests to Cruise Control pertaining to this
+	// KafkaRebalance resource. This is used by the Kafka Rebalance operator to
+	// track the status of ongoing rebalancing operations.
+	SessionID *string `json:"sessionId,omitempty"`
+	// A JSON object describing the optimization result.
+	OptimizationResult *runtime.RawExtension `json:"optimizationResult,omitempty"`
+}
+
+// KafkaRebalanceStatusApplyConfiguration constructs a declarative configuration of the KafkaRebalanceStatus type for use with
+// apply.
+func KafkaRebalanceStatus() *KafkaRebalanceStatusApplyConfiguration {
+	return &KafkaRebalanceStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaRebalanceStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaRebalanceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaRebalanceStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaRebalanceStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithSessionID sets the SessionID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SessionID field is set to the value of the last call.
+func (b *KafkaRebalanceStatusApplyConfiguration) WithSessionID(value string) *KafkaRebalanceStatusApplyConfiguration {
+	b.SessionID = &value
+	return b
+}
+
+// WithOptimizationResult sets the OptimizationResult field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OptimizationResult field is set to the value of the last call.
+func (b *KafkaRebalanceStatusApplyConfiguration) WithOptimizationResult(value runtime.RawExtension) *KafkaRebalanceStatusApplyConfiguration {
+	b.OptimizationResult = &value
+	return b
+}
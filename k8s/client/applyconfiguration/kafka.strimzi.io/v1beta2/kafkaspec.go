@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaSpecApplyConfiguration represents a declarative configuration of the KafkaSpec type for use
+// with apply.
+type KafkaSpecApplyConfiguration struct {
+	// Configuration of the Kafka cluster.
+	Kafka *KafkaClusterSpecApplyConfiguration `json:"kafka,omitempty"`
+	// Configuration of the ZooKeeper cluster. This section is required when
+	// running a ZooKeeper-based Apache Kafka cluster.
+	Zookeeper *ZookeeperClusterSpecApplyConfiguration `json:"zookeeper,omitempty"`
+	// Configuration of the Entity Operator.
+	EntityOperator *EntityOperatorSpecApplyConfiguration `json:"entityOperator,omitempty"`
+	// Configuration for Cruise Control deployment. Deploys a Cruise Control
+	// instance when specified.
+	CruiseControl *CruiseControlSpecApplyConfiguration `json:"cruiseControl,omitempty"`
+	// Configuration of the cluster certificate authority.
+	ClusterCa *CertificateAuthorityApplyConfiguration `json:"clusterCa,omitempty"`
+	// Configuration of the clients certificate authority.
+	ClientsCa *CertificateAuthorityApplyConfiguration `json:"clientsCa,omitempty"`
+	// Configuration of the Kafka Exporter. Kafka Exporter can provide additional
+	// metrics, for example lag of consumer group at topic/partition.
+	KafkaExporter *runtime.RawExtension `json:"kafkaExporter,omitempty"`
+	// A list of time windows for maintenance tasks (that is, certificates
+	// renewal). Each time window is defined by a cron expression.
+	MaintenanceTimeWindows []string `json:"maintenanceTimeWindows,omitempty"`
+}
+
+// KafkaSpecApplyConfiguration constructs a declarative configuration of the KafkaSpec type for use with
+// apply.
+func KafkaSpec() *KafkaSpecApplyConfiguration {
+	return &KafkaSpecApplyConfiguration{}
+}
+
+// WithKafka sets the Kafka field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kafka field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithKafka(value *KafkaClusterSpecApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.Kafka = value
+	return b
+}
+
+// WithZookeeper sets the Zookeeper field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zookeeper field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithZookeeper(value *ZookeeperClusterSpecApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.Zookeeper = value
+	return b
+}
+
+// WithEntityOperator sets the EntityOperator field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EntityOperator field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithEntityOperator(value *EntityOperatorSpecApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.EntityOperator = value
+	return b
+}
+
+// WithCruiseControl sets the CruiseControl field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CruiseControl field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithCruiseControl(value *CruiseControlSpecApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.CruiseControl = value
+	return b
+}
+
+// WithClusterCa sets the ClusterCa field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterCa field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithClusterCa(value *CertificateAuthorityApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.ClusterCa = value
+	return b
+}
+
+// WithClientsCa sets the ClientsCa field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientsCa field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithClientsCa(value *CertificateAuthorityApplyConfiguration) *KafkaSpecApplyConfiguration {
+	b.ClientsCa = value
+	return b
+}
+
+// WithKafkaExporter sets the KafkaExporter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KafkaExporter field is set to the value of the last call.
+func (b *KafkaSpecApplyConfiguration) WithKafkaExporter(value runtime.RawExtension) *KafkaSpecApplyConfiguration {
+	b.KafkaExporter = &value
+	return b
+}
+
+// WithMaintenanceTimeWindows adds the given value to the MaintenanceTimeWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceTimeWindows field.
+func (b *KafkaSpecApplyConfiguration) WithMaintenanceTimeWindows(values ...string) *KafkaSpecApplyConfiguration {
+	for i := range values {
+		b.MaintenanceTimeWindows = append(b.MaintenanceTimeWindows, values[i])
+	}
+	return b
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaStatusApplyConfiguration represents a declarative configuration of the KafkaStatus type for use
+// with apply.
+//
+// The status of the Kafka and ZooKeeper clusters, and Topic Operator.
+type KafkaStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// Addresses of the internal and external listeners.
+	Listeners []ListenerStatusApplyConfiguration `json:"listeners,omitempty"`
+	// List of the KafkaNodePools used by this Kafka cluster.
+	KafkaNodePools []UsedNodePoolStatusApplyConfiguration `json:"kafkaNodePools,omitempty"`
+	// Registered node IDs used by this Kafka cluster. This field is used for
+	// internal purposes only and will be removed in the future.
+	RegisteredNodeIDs []int32 `json:"registeredNodeIds,omitempty"`
+	// Kafka cluster Id.
+	ClusterID *string `json:"clusterId,omitempty"`
+	// The version of the Strimzi Cluster Operator which performed the last
+	// successful reconciliation.
+	OperatorLastSuccessfulVersion *string `json:"operatorLastSuccessfulVersion,omitempty"`
+	// The version of Kafka currently deployed in the cluster.
+	KafkaVersion *string `json:"kafkaVersion,omitempty"`
+	// The KRaft metadata.version currently used by the Kafka cluster.
+	KafkaMetadataVersion *string `json:"kafkaMetadataVersion,omitempty"`
+	// Defines where cluster metadata are stored. Possible values are: ZooKeeper
+	// if the metadata are stored in ZooKeeper; KRaftMigration if the controllers
+	// are connected to ZooKeeper, brokers are being rolled with Zookeeper
+	// migration enabled and connection information to controllers, and the
+	// metadata migration process is running; KRaftDualWriting if the metadata
+	// migration process finished and the cluster is in dual-write mode;
+	// KRaftPostMigration if the brokers are fully KRaft-based but controllers
+	// being rolled to disconnect from ZooKeeper; PreKRaft if brokers and
+	// controller are fully KRaft-based, metadata are stored in KRaft, but
+	// ZooKeeper must be deleted; KRaft if the metadata are stored in KRaft.
+	KafkaMetadataState *string `json:"kafkaMetadataState,omitempty"`
+	// The status of an auto-rebalancing triggered by a cluster scaling request.
+	AutoRebalance *runtime.RawExtension `json:"autoRebalance,omitempty"`
+}
+
+// KafkaStatusApplyConfiguration constructs a declarative configuration of the KafkaStatus type for use with
+// apply.
+func KafkaStatus() *KafkaStatusApplyConfiguration {
+	return &KafkaStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *KafkaStatusApplyConfiguration) WithListeners(values ...*ListenerStatusApplyConfiguration) *KafkaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
+
+// WithKafkaNodePools adds the given value to the KafkaNodePools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the KafkaNodePools field.
+func (b *KafkaStatusApplyConfiguration) WithKafkaNodePools(values ...*UsedNodePoolStatusApplyConfiguration) *KafkaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithKafkaNodePools")
+		}
+		b.KafkaNodePools = append(b.KafkaNodePools, *values[i])
+	}
+	return b
+}
+
+// WithRegisteredNodeIDs adds the given value to the RegisteredNodeIDs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RegisteredNodeIDs field.
+func (b *KafkaStatusApplyConfiguration) WithRegisteredNodeIDs(values ...int32) *KafkaStatusApplyConfiguration {
+	for i := range values {
+		b.RegisteredNodeIDs = append(b.RegisteredNodeIDs, values[i])
+	}
+	return b
+}
+
+// WithClusterID sets the ClusterID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterID field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithClusterID(value string) *KafkaStatusApplyConfiguration {
+	b.ClusterID = &value
+	return b
+}
+
+// WithOperatorLastSuccessfulVersion sets the OperatorLastSuccessfulVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatorLastSuccessfulVersion field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithOperatorLastSuccessfulVersion(value string) *KafkaStatusApplyConfiguration {
+	b.OperatorLastSuccessfulVersion = &value
+	return b
+}
+
+// WithKafkaVersion sets the KafkaVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KafkaVersion field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithKafkaVersion(value string) *KafkaStatusApplyConfiguration {
+	b.KafkaVersion = &value
+	return b
+}
+
+// WithKafkaMetadataVersion sets the KafkaMetadataVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KafkaMetadataVersion field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithKafkaMetadataVersion(value string) *KafkaStatusApplyConfiguration {
+	b.KafkaMetadataVersion = &value
+	return b
+}
+
+// WithKafkaMetadataState sets the KafkaMetadataState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KafkaMetadataState field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithKafkaMetadataState(value string) *KafkaStatusApplyConfiguration {
+	b.KafkaMetadataState = &value
+	return b
+}
+
+// WithAutoRebalance sets the AutoRebalance field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoRebalance field is set to the value of the last call.
+func (b *KafkaStatusApplyConfiguration) WithAutoRebalance(value runtime.RawExtension) *KafkaStatusApplyConfiguration {
+	b.AutoRebalance = &value
+	return b
+}
@@ -29,7 +29,7 @@ func KafkaTopic(name, namespace string) *KafkaTopicApplyConfiguration {
 	b.WithName(name)
 	b.WithNamespace(namespace)
 	b.WithKind("KafkaTopic")
-	b.WithAPIVersion("kafka/v1beta2")
+	b.WithAPIVersion("kafka.strimzi.io/v1beta2")
 	return b
 }
 
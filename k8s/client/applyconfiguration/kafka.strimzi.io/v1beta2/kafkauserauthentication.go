@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaUserAuthenticationApplyConfiguration represents a declarative configuration of the KafkaUserAuthentication type for use
+// with apply.
+type KafkaUserAuthenticationApplyConfiguration struct {
+	// Authentication type.
+	Type *string `json:"type,omitempty"`
+	// Specify the password for the user. If not set, a new password is generated
+	// by the User Operator. Only used with type `scram-sha-512`.
+	Password *PasswordApplyConfiguration `json:"password,omitempty"`
+}
+
+// KafkaUserAuthenticationApplyConfiguration constructs a declarative configuration of the KafkaUserAuthentication type for use with
+// apply.
+func KafkaUserAuthentication() *KafkaUserAuthenticationApplyConfiguration {
+	return &KafkaUserAuthenticationApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *KafkaUserAuthenticationApplyConfiguration) WithType(value string) *KafkaUserAuthenticationApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithPassword sets the Password field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Password field is set to the value of the last call.
+func (b *KafkaUserAuthenticationApplyConfiguration) WithPassword(value *PasswordApplyConfiguration) *KafkaUserAuthenticationApplyConfiguration {
+	b.Password = value
+	return b
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaUserAuthorizationApplyConfiguration represents a declarative configuration of the KafkaUserAuthorization type for use
+// with apply.
+type KafkaUserAuthorizationApplyConfiguration struct {
+	// Authorization type. Currently the only supported type is `simple`. `simple`
+	// authorization type uses the Kafka Admin API for managing the ACL rules.
+	Type *string `json:"type,omitempty"`
+	// List of ACL rules which should be applied to this user.
+	Acls []AclRuleApplyConfiguration `json:"acls,omitempty"`
+}
+
+// KafkaUserAuthorizationApplyConfiguration constructs a declarative configuration of the KafkaUserAuthorization type for use with
+// apply.
+func KafkaUserAuthorization() *KafkaUserAuthorizationApplyConfiguration {
+	return &KafkaUserAuthorizationApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *KafkaUserAuthorizationApplyConfiguration) WithType(value string) *KafkaUserAuthorizationApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithAcls adds the given value to the Acls field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Acls field.
+func (b *KafkaUserAuthorizationApplyConfiguration) WithAcls(values ...*AclRuleApplyConfiguration) *KafkaUserAuthorizationApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAcls")
+		}
+		b.Acls = append(b.Acls, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaUserQuotasApplyConfiguration represents a declarative configuration of the KafkaUserQuotas type for use
+// with apply.
+type KafkaUserQuotasApplyConfiguration struct {
+	// A quota on the maximum bytes per-second that each client group can publish
+	// to a broker before the clients in the group are throttled. Defined on a
+	// per-broker basis.
+	ProducerByteRate *int32 `json:"producerByteRate,omitempty"`
+	// A quota on the maximum bytes per-second that each client group can fetch
+	// from a broker before the clients in the group are throttled. Defined on a
+	// per-broker basis.
+	ConsumerByteRate *int32 `json:"consumerByteRate,omitempty"`
+	// A quota on the maximum CPU utilization of each client group as a
+	// percentage of network and I/O threads.
+	RequestPercentage *int32 `json:"requestPercentage,omitempty"`
+	// A quota on the rate at which mutations are accepted for the create topics
+	// request, the create partitions request and the delete topics request. The
+	// rate is accumulated by the number of partitions created or deleted.
+	ControllerMutationRate *float64 `json:"controllerMutationRate,omitempty"`
+}
+
+// KafkaUserQuotasApplyConfiguration constructs a declarative configuration of the KafkaUserQuotas type for use with
+// apply.
+func KafkaUserQuotas() *KafkaUserQuotasApplyConfiguration {
+	return &KafkaUserQuotasApplyConfiguration{}
+}
+
+// WithProducerByteRate sets the ProducerByteRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProducerByteRate field is set to the value of the last call.
+func (b *KafkaUserQuotasApplyConfiguration) WithProducerByteRate(value int32) *KafkaUserQuotasApplyConfiguration {
+	b.ProducerByteRate = &value
+	return b
+}
+
+// WithConsumerByteRate sets the ConsumerByteRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConsumerByteRate field is set to the value of the last call.
+func (b *KafkaUserQuotasApplyConfiguration) WithConsumerByteRate(value int32) *KafkaUserQuotasApplyConfiguration {
+	b.ConsumerByteRate = &value
+	return b
+}
+
+// WithRequestPercentage sets the RequestPercentage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestPercentage field is set to the value of the last call.
+func (b *KafkaUserQuotasApplyConfiguration) WithRequestPercentage(value int32) *KafkaUserQuotasApplyConfiguration {
+	b.RequestPercentage = &value
+	return b
+}
+
+// WithControllerMutationRate sets the ControllerMutationRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControllerMutationRate field is set to the value of the last call.
+func (b *KafkaUserQuotasApplyConfiguration) WithControllerMutationRate(value float64) *KafkaUserQuotasApplyConfiguration {
+	b.ControllerMutationRate = &value
+	return b
+}
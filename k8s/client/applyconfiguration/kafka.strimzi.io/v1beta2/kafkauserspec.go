@@ -0,0 +1,63 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// KafkaUserSpecApplyConfiguration represents a declarative configuration of the KafkaUserSpec type for use
+// with apply.
+type KafkaUserSpecApplyConfiguration struct {
+	// Authentication mechanism enabled for this Kafka user. The supported
+	// authentication mechanisms are `scram-sha-512`, `tls`, and `tls-external`.
+	Authentication *KafkaUserAuthenticationApplyConfiguration `json:"authentication,omitempty"`
+	// Authorization rules for this Kafka user.
+	Authorization *KafkaUserAuthorizationApplyConfiguration `json:"authorization,omitempty"`
+	// Quotas on requests to control the broker resources used by clients.
+	// Network bandwidth and request rate quotas can be enforced.
+	Quotas *KafkaUserQuotasApplyConfiguration `json:"quotas,omitempty"`
+	// Template to specify how Kafka User `Secrets` are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// KafkaUserSpecApplyConfiguration constructs a declarative configuration of the KafkaUserSpec type for use with
+// apply.
+func KafkaUserSpec() *KafkaUserSpecApplyConfiguration {
+	return &KafkaUserSpecApplyConfiguration{}
+}
+
+// WithAuthentication sets the Authentication field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authentication field is set to the value of the last call.
+func (b *KafkaUserSpecApplyConfiguration) WithAuthentication(value *KafkaUserAuthenticationApplyConfiguration) *KafkaUserSpecApplyConfiguration {
+	b.Authentication = value
+	return b
+}
+
+// WithAuthorization sets the Authorization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Authorization field is set to the value of the last call.
+func (b *KafkaUserSpecApplyConfiguration) WithAuthorization(value *KafkaUserAuthorizationApplyConfiguration) *KafkaUserSpecApplyConfiguration {
+	b.Authorization = value
+	return b
+}
+
+// WithQuotas sets the Quotas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Quotas field is set to the value of the last call.
+func (b *KafkaUserSpecApplyConfiguration) WithQuotas(value *KafkaUserQuotasApplyConfiguration) *KafkaUserSpecApplyConfiguration {
+	b.Quotas = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *KafkaUserSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *KafkaUserSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
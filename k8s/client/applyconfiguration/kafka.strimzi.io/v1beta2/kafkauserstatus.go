@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// KafkaUserStatusApplyConfiguration represents a declarative configuration of the KafkaUserStatus type for use
+// with apply.
+//
+// The status of the Kafka User.
+type KafkaUserStatusApplyConfiguration struct {
+	// List of status conditions.
+	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// The generation of the CRD that was last reconciled by the operator.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// Username.
+	Username *string `json:"username,omitempty"`
+	// The name of `Secret` where the credentials are stored.
+	Secret *string `json:"secret,omitempty"`
+}
+
+// KafkaUserStatusApplyConfiguration constructs a declarative configuration of the KafkaUserStatus type for use with
+// apply.
+func KafkaUserStatus() *KafkaUserStatusApplyConfiguration {
+	return &KafkaUserStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *KafkaUserStatusApplyConfiguration) WithConditions(values ...*ConditionApplyConfiguration) *KafkaUserStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *KafkaUserStatusApplyConfiguration) WithObservedGeneration(value int64) *KafkaUserStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithUsername sets the Username field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Username field is set to the value of the last call.
+func (b *KafkaUserStatusApplyConfiguration) WithUsername(value string) *KafkaUserStatusApplyConfiguration {
+	b.Username = &value
+	return b
+}
+
+// WithSecret sets the Secret field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Secret field is set to the value of the last call.
+func (b *KafkaUserStatusApplyConfiguration) WithSecret(value string) *KafkaUserStatusApplyConfiguration {
+	b.Secret = &value
+	return b
+}
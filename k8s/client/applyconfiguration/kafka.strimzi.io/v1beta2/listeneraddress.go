@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ListenerAddressApplyConfiguration represents a declarative configuration of the ListenerAddress type for use
+// with apply.
+type ListenerAddressApplyConfiguration struct {
+	// The DNS name or IP address of the Kafka bootstrap service.
+	Host *string `json:"host,omitempty"`
+	// The port of the Kafka bootstrap service.
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ListenerAddressApplyConfiguration constructs a declarative configuration of the ListenerAddress type for use with
+// apply.
+func ListenerAddress() *ListenerAddressApplyConfiguration {
+	return &ListenerAddressApplyConfiguration{}
+}
+
+// WithHost sets the Host field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Host field is set to the value of the last call.
+func (b *ListenerAddressApplyConfiguration) WithHost(value string) *ListenerAddressApplyConfiguration {
+	b.Host = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *ListenerAddressApplyConfiguration) WithPort(value int32) *ListenerAddressApplyConfiguration {
+	b.Port = &value
+	return b
+}
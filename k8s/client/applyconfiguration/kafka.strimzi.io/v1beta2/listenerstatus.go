@@ -0,0 +1,67 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// ListenerStatusApplyConfiguration represents a declarative configuration of the ListenerStatus type for use
+// with apply.
+type ListenerStatusApplyConfiguration struct {
+	// The name of the listener.
+	Name *string `json:"name,omitempty"`
+	// A list of the addresses for this listener.
+	Addresses []ListenerAddressApplyConfiguration `json:"addresses,omitempty"`
+	// A comma-separated list of `host:port` pairs for connecting to the Kafka
+	// cluster using this listener.
+	BootstrapServers *string `json:"bootstrapServers,omitempty"`
+	// A list of TLS certificates which can be used to verify the identity of the
+	// server when connecting to the given listener. Set only for `tls` and
+	// `external` listeners.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// ListenerStatusApplyConfiguration constructs a declarative configuration of the ListenerStatus type for use with
+// apply.
+func ListenerStatus() *ListenerStatusApplyConfiguration {
+	return &ListenerStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ListenerStatusApplyConfiguration) WithName(value string) *ListenerStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *ListenerStatusApplyConfiguration) WithAddresses(values ...*ListenerAddressApplyConfiguration) *ListenerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAddresses")
+		}
+		b.Addresses = append(b.Addresses, *values[i])
+	}
+	return b
+}
+
+// WithBootstrapServers sets the BootstrapServers field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapServers field is set to the value of the last call.
+func (b *ListenerStatusApplyConfiguration) WithBootstrapServers(value string) *ListenerStatusApplyConfiguration {
+	b.BootstrapServers = &value
+	return b
+}
+
+// WithCertificates adds the given value to the Certificates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Certificates field.
+func (b *ListenerStatusApplyConfiguration) WithCertificates(values ...string) *ListenerStatusApplyConfiguration {
+	for i := range values {
+		b.Certificates = append(b.Certificates, values[i])
+	}
+	return b
+}
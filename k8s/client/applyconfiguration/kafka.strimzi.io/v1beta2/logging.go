@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// LoggingApplyConfiguration represents a declarative configuration of the Logging type for use
+// with apply.
+//
+// Logging configures the loggers of a Strimzi managed component.
+type LoggingApplyConfiguration struct {
+	// Logging type, must be either 'inline' or 'external'.
+	Type *string `json:"type,omitempty"`
+	// A Map from logger name to logger level. Only used with type 'inline'.
+	Loggers map[string]string `json:"loggers,omitempty"`
+	// `ConfigMap` entry where the logging configuration is stored. Only used with
+	// type 'external'.
+	ValueFrom *ExternalConfigurationReferenceApplyConfiguration `json:"valueFrom,omitempty"`
+}
+
+// LoggingApplyConfiguration constructs a declarative configuration of the Logging type for use with
+// apply.
+func Logging() *LoggingApplyConfiguration {
+	return &LoggingApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *LoggingApplyConfiguration) WithType(value string) *LoggingApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithLoggers puts the entries into the Loggers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Loggers field,
+// overwriting an existing map entries in Loggers field with the same key.
+func (b *LoggingApplyConfiguration) WithLoggers(entries map[string]string) *LoggingApplyConfiguration {
+	if b.Loggers == nil && len(entries) > 0 {
+		b.Loggers = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Loggers[k] = v
+	}
+	return b
+}
+
+// WithValueFrom sets the ValueFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValueFrom field is set to the value of the last call.
+func (b *LoggingApplyConfiguration) WithValueFrom(value *ExternalConfigurationReferenceApplyConfiguration) *LoggingApplyConfiguration {
+	b.ValueFrom = value
+	return b
+}
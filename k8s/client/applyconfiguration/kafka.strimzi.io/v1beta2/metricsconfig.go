@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// MetricsConfigApplyConfiguration represents a declarative configuration of the MetricsConfig type for use
+// with apply.
+//
+// MetricsConfig configures the Prometheus JMX exporter of a Strimzi managed component.
+type MetricsConfigApplyConfiguration struct {
+	// Metrics type. Only 'jmxPrometheusExporter' supported currently.
+	Type *string `json:"type,omitempty"`
+	// ConfigMap entry where the Prometheus JMX Exporter configuration is stored.
+	ValueFrom *ExternalConfigurationReferenceApplyConfiguration `json:"valueFrom,omitempty"`
+}
+
+// MetricsConfigApplyConfiguration constructs a declarative configuration of the MetricsConfig type for use with
+// apply.
+func MetricsConfig() *MetricsConfigApplyConfiguration {
+	return &MetricsConfigApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MetricsConfigApplyConfiguration) WithType(value string) *MetricsConfigApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithValueFrom sets the ValueFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValueFrom field is set to the value of the last call.
+func (b *MetricsConfigApplyConfiguration) WithValueFrom(value *ExternalConfigurationReferenceApplyConfiguration) *MetricsConfigApplyConfiguration {
+	b.ValueFrom = value
+	return b
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// PasswordApplyConfiguration represents a declarative configuration of the Password type for use
+// with apply.
+type PasswordApplyConfiguration struct {
+	// Secret from which the password should be read.
+	ValueFrom *PasswordSourceApplyConfiguration `json:"valueFrom,omitempty"`
+}
+
+// PasswordApplyConfiguration constructs a declarative configuration of the Password type for use with
+// apply.
+func Password() *PasswordApplyConfiguration {
+	return &PasswordApplyConfiguration{}
+}
+
+// WithValueFrom sets the ValueFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValueFrom field is set to the value of the last call.
+func (b *PasswordApplyConfiguration) WithValueFrom(value *PasswordSourceApplyConfiguration) *PasswordApplyConfiguration {
+	b.ValueFrom = value
+	return b
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// PasswordSourceApplyConfiguration represents a declarative configuration of the PasswordSource type for use
+// with apply.
+type PasswordSourceApplyConfiguration struct {
+	// Selects a key of a Secret in the resource's namespace.
+	SecretKeyRef *v1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// PasswordSourceApplyConfiguration constructs a declarative configuration of the PasswordSource type for use with
+// apply.
+func PasswordSource() *PasswordSourceApplyConfiguration {
+	return &PasswordSourceApplyConfiguration{}
+}
+
+// WithSecretKeyRef sets the SecretKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretKeyRef field is set to the value of the last call.
+func (b *PasswordSourceApplyConfiguration) WithSecretKeyRef(value v1.SecretKeySelector) *PasswordSourceApplyConfiguration {
+	b.SecretKeyRef = &value
+	return b
+}
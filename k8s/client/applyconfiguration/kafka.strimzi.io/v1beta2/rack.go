@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// RackApplyConfiguration represents a declarative configuration of the Rack type for use
+// with apply.
+//
+// Rack configures the rack awareness of Kafka brokers.
+type RackApplyConfiguration struct {
+	// A key that matches labels assigned to the Kubernetes cluster nodes. The
+	// value of the label is used to set a broker's `broker.rack` config, and the
+	// `client.rack` config for Kafka Connect or MirrorMaker 2.
+	TopologyKey *string `json:"topologyKey,omitempty"`
+}
+
+// RackApplyConfiguration constructs a declarative configuration of the Rack type for use with
+// apply.
+func Rack() *RackApplyConfiguration {
+	return &RackApplyConfiguration{}
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *RackApplyConfiguration) WithTopologyKey(value string) *RackApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// StorageApplyConfiguration represents a declarative configuration of the Storage type for use
+// with apply.
+//
+// Storage configures the storage of a Kafka or ZooKeeper node.
+type StorageApplyConfiguration struct {
+	// Storage type, must be either 'ephemeral', 'persistent-claim', or 'jbod'.
+	Type *string `json:"type,omitempty"`
+	// Storage identification number. It is mandatory only for storage volumes
+	// defined in a storage of type 'jbod'.
+	ID *int32 `json:"id,omitempty"`
+	// When type=persistent-claim, defines the size of the persistent volume claim,
+	// such as 100Gi. Mandatory when type=persistent-claim.
+	Size *string `json:"size,omitempty"`
+	// When type=ephemeral, defines the total amount of local storage required for
+	// this EmptyDir volume (for example 1Gi).
+	SizeLimit *string `json:"sizeLimit,omitempty"`
+	// The storage class to use for dynamic volume allocation.
+	Class *string `json:"class,omitempty"`
+	// Specifies if the persistent volume claim has to be deleted when the cluster
+	// is un-deployed.
+	DeleteClaim *bool `json:"deleteClaim,omitempty"`
+	// Specifies whether this volume should be used for storing KRaft metadata.
+	// This property is optional. When set, the only currently supported value is
+	// `shared`. At most one volume can have this property set.
+	KraftMetadata *string `json:"kraftMetadata,omitempty"`
+	// List of volumes as Storage objects representing the JBOD disks array.
+	Volumes []StorageApplyConfiguration `json:"volumes,omitempty"`
+}
+
+// StorageApplyConfiguration constructs a declarative configuration of the Storage type for use with
+// apply.
+func Storage() *StorageApplyConfiguration {
+	return &StorageApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithType(value string) *StorageApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithID(value int32) *StorageApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithSize(value string) *StorageApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithSizeLimit sets the SizeLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SizeLimit field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithSizeLimit(value string) *StorageApplyConfiguration {
+	b.SizeLimit = &value
+	return b
+}
+
+// WithClass sets the Class field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Class field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithClass(value string) *StorageApplyConfiguration {
+	b.Class = &value
+	return b
+}
+
+// WithDeleteClaim sets the DeleteClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeleteClaim field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithDeleteClaim(value bool) *StorageApplyConfiguration {
+	b.DeleteClaim = &value
+	return b
+}
+
+// WithKraftMetadata sets the KraftMetadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KraftMetadata field is set to the value of the last call.
+func (b *StorageApplyConfiguration) WithKraftMetadata(value string) *StorageApplyConfiguration {
+	b.KraftMetadata = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *StorageApplyConfiguration) WithVolumes(values ...*StorageApplyConfiguration) *StorageApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SystemPropertyApplyConfiguration represents a declarative configuration of the SystemProperty type for use
+// with apply.
+//
+// SystemProperty is a single Java system property.
+type SystemPropertyApplyConfiguration struct {
+	// The system property name.
+	Name *string `json:"name,omitempty"`
+	// The system property value.
+	Value *string `json:"value,omitempty"`
+}
+
+// SystemPropertyApplyConfiguration constructs a declarative configuration of the SystemProperty type for use with
+// apply.
+func SystemProperty() *SystemPropertyApplyConfiguration {
+	return &SystemPropertyApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SystemPropertyApplyConfiguration) WithName(value string) *SystemPropertyApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *SystemPropertyApplyConfiguration) WithValue(value string) *SystemPropertyApplyConfiguration {
+	b.Value = &value
+	return b
+}
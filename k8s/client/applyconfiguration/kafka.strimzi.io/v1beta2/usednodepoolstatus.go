@@ -0,0 +1,27 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// UsedNodePoolStatusApplyConfiguration represents a declarative configuration of the UsedNodePoolStatus type for use
+// with apply.
+type UsedNodePoolStatusApplyConfiguration struct {
+	// The name of the KafkaNodePool used by this Kafka resource.
+	Name *string `json:"name,omitempty"`
+}
+
+// UsedNodePoolStatusApplyConfiguration constructs a declarative configuration of the UsedNodePoolStatus type for use with
+// apply.
+func UsedNodePoolStatus() *UsedNodePoolStatusApplyConfiguration {
+	return &UsedNodePoolStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *UsedNodePoolStatusApplyConfiguration) WithName(value string) *UsedNodePoolStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
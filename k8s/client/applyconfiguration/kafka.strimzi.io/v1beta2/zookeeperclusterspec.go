@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// ZookeeperClusterSpecApplyConfiguration represents a declarative configuration of the ZookeeperClusterSpec type for use
+// with apply.
+type ZookeeperClusterSpecApplyConfiguration struct {
+	// The number of pods in the cluster.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// The container image used for ZooKeeper pods.
+	Image *string `json:"image,omitempty"`
+	// Storage configuration (disk). Cannot be updated.
+	Storage *StorageApplyConfiguration `json:"storage,omitempty"`
+	// The ZooKeeper broker config.
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Pod liveness checking.
+	LivenessProbe *ProbeApplyConfiguration `json:"livenessProbe,omitempty"`
+	// Pod readiness checking.
+	ReadinessProbe *ProbeApplyConfiguration `json:"readinessProbe,omitempty"`
+	// JVM Options for pods.
+	JvmOptions *JvmOptionsApplyConfiguration `json:"jvmOptions,omitempty"`
+	// CPU and memory resources to reserve.
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+	// Metrics configuration.
+	MetricsConfig *MetricsConfigApplyConfiguration `json:"metricsConfig,omitempty"`
+	// Logging configuration for ZooKeeper.
+	Logging *LoggingApplyConfiguration `json:"logging,omitempty"`
+	// Template for ZooKeeper cluster resources. The template allows users to
+	// specify how the Kubernetes resources are generated.
+	Template *runtime.RawExtension `json:"template,omitempty"`
+}
+
+// ZookeeperClusterSpecApplyConfiguration constructs a declarative configuration of the ZookeeperClusterSpec type for use with
+// apply.
+func ZookeeperClusterSpec() *ZookeeperClusterSpecApplyConfiguration {
+	return &ZookeeperClusterSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithReplicas(value int32) *ZookeeperClusterSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithImage(value string) *ZookeeperClusterSpecApplyConfiguration {
+	b.Image = &value
+	return b
+}
+
+// WithStorage sets the Storage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Storage field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithStorage(value *StorageApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.Storage = value
+	return b
+}
+
+// WithConfig sets the Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Config field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithConfig(value runtime.RawExtension) *ZookeeperClusterSpecApplyConfiguration {
+	b.Config = &value
+	return b
+}
+
+// WithLivenessProbe sets the LivenessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LivenessProbe field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithLivenessProbe(value *ProbeApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.LivenessProbe = value
+	return b
+}
+
+// WithReadinessProbe sets the ReadinessProbe field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadinessProbe field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithReadinessProbe(value *ProbeApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.ReadinessProbe = value
+	return b
+}
+
+// WithJvmOptions sets the JvmOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JvmOptions field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithJvmOptions(value *JvmOptionsApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.JvmOptions = value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithResources(value v1.ResourceRequirements) *ZookeeperClusterSpecApplyConfiguration {
+	b.Resources = &value
+	return b
+}
+
+// WithMetricsConfig sets the MetricsConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MetricsConfig field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithMetricsConfig(value *MetricsConfigApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.MetricsConfig = value
+	return b
+}
+
+// WithLogging sets the Logging field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logging field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithLogging(value *LoggingApplyConfiguration) *ZookeeperClusterSpecApplyConfiguration {
+	b.Logging = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *ZookeeperClusterSpecApplyConfiguration) WithTemplate(value runtime.RawExtension) *ZookeeperClusterSpecApplyConfiguration {
+	b.Template = &value
+	return b
+}
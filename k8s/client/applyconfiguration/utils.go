@@ -6,8 +6,10 @@
 package applyconfiguration
 
 import (
+	v1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
 	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
 	internal "github.com/bborbe/strimzi/k8s/client/applyconfiguration/internal"
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1"
 	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,7 +20,137 @@ import (
 // apply configuration type exists for the given GroupVersionKind.
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
-	// Group=kafka, Version=v1beta2
+	// Group=kafka.strimzi.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("Condition"):
+		return &kafkastrimziiov1.ConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KafkaTopic"):
+		return &kafkastrimziiov1.KafkaTopicApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KafkaTopicSpec"):
+		return &kafkastrimziiov1.KafkaTopicSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("KafkaTopicStatus"):
+		return &kafkastrimziiov1.KafkaTopicStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ReplicasChangeStatus"):
+		return &kafkastrimziiov1.ReplicasChangeStatusApplyConfiguration{}
+
+		// Group=kafka.strimzi.io, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithKind("AclRule"):
+		return &kafkastrimziiov1beta2.AclRuleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AclRuleResource"):
+		return &kafkastrimziiov1beta2.AclRuleResourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AutoRestart"):
+		return &kafkastrimziiov1beta2.AutoRestartApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AutoRestartStatus"):
+		return &kafkastrimziiov1beta2.AutoRestartStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BrokerAndVolumeIDs"):
+		return &kafkastrimziiov1beta2.BrokerAndVolumeIDsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Build"):
+		return &kafkastrimziiov1beta2.BuildApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BuildArtifact"):
+		return &kafkastrimziiov1beta2.BuildArtifactApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BuildOutput"):
+		return &kafkastrimziiov1beta2.BuildOutputApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BuildPlugin"):
+		return &kafkastrimziiov1beta2.BuildPluginApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CertificateAuthority"):
+		return &kafkastrimziiov1beta2.CertificateAuthorityApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CertSecretSource"):
+		return &kafkastrimziiov1beta2.CertSecretSourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ClientTLS"):
+		return &kafkastrimziiov1beta2.ClientTLSApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Condition"):
+		return &kafkastrimziiov1beta2.ConditionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ConnectorPlugin"):
+		return &kafkastrimziiov1beta2.ConnectorPluginApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ConnectorState"):
+		return &kafkastrimziiov1beta2.ConnectorStateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ConnectorStatus"):
+		return &kafkastrimziiov1beta2.ConnectorStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ConnectorTaskState"):
+		return &kafkastrimziiov1beta2.ConnectorTaskStateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("CruiseControlSpec"):
+		return &kafkastrimziiov1beta2.CruiseControlSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("EntityOperatorSpec"):
+		return &kafkastrimziiov1beta2.EntityOperatorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("EntityTopicOperatorSpec"):
+		return &kafkastrimziiov1beta2.EntityTopicOperatorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("EntityUserOperatorSpec"):
+		return &kafkastrimziiov1beta2.EntityUserOperatorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ExternalConfiguration"):
+		return &kafkastrimziiov1beta2.ExternalConfigurationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ExternalConfigurationEnv"):
+		return &kafkastrimziiov1beta2.ExternalConfigurationEnvApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ExternalConfigurationEnvVarSource"):
+		return &kafkastrimziiov1beta2.ExternalConfigurationEnvVarSourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ExternalConfigurationReference"):
+		return &kafkastrimziiov1beta2.ExternalConfigurationReferenceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ExternalConfigurationVolumeSource"):
+		return &kafkastrimziiov1beta2.ExternalConfigurationVolumeSourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("GenericKafkaListener"):
+		return &kafkastrimziiov1beta2.GenericKafkaListenerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("JvmOptions"):
+		return &kafkastrimziiov1beta2.JvmOptionsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Kafka"):
+		return &kafkastrimziiov1beta2.KafkaApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaAutoRebalanceConfiguration"):
+		return &kafkastrimziiov1beta2.KafkaAutoRebalanceConfigurationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridge"):
+		return &kafkastrimziiov1beta2.KafkaBridgeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeAdminClientSpec"):
+		return &kafkastrimziiov1beta2.KafkaBridgeAdminClientSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeConsumerSpec"):
+		return &kafkastrimziiov1beta2.KafkaBridgeConsumerSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeHTTPConfig"):
+		return &kafkastrimziiov1beta2.KafkaBridgeHTTPConfigApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeHTTPCors"):
+		return &kafkastrimziiov1beta2.KafkaBridgeHTTPCorsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeProducerSpec"):
+		return &kafkastrimziiov1beta2.KafkaBridgeProducerSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeSpec"):
+		return &kafkastrimziiov1beta2.KafkaBridgeSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaBridgeStatus"):
+		return &kafkastrimziiov1beta2.KafkaBridgeStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaClusterSpec"):
+		return &kafkastrimziiov1beta2.KafkaClusterSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnect"):
+		return &kafkastrimziiov1beta2.KafkaConnectApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnector"):
+		return &kafkastrimziiov1beta2.KafkaConnectorApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnectorSpec"):
+		return &kafkastrimziiov1beta2.KafkaConnectorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnectorStatus"):
+		return &kafkastrimziiov1beta2.KafkaConnectorStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnectSpec"):
+		return &kafkastrimziiov1beta2.KafkaConnectSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaConnectStatus"):
+		return &kafkastrimziiov1beta2.KafkaConnectStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2ClusterSpec"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2ClusterSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2ConnectorSpec"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2ConnectorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2MirrorSpec"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2MirrorSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2Spec"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2SpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2Status"):
+		return &kafkastrimziiov1beta2.KafkaMirrorMaker2StatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaNodePool"):
+		return &kafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaNodePoolSpec"):
+		return &kafkastrimziiov1beta2.KafkaNodePoolSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaNodePoolStatus"):
+		return &kafkastrimziiov1beta2.KafkaNodePoolStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaRebalance"):
+		return &kafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaRebalanceSpec"):
+		return &kafkastrimziiov1beta2.KafkaRebalanceSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaRebalanceStatus"):
+		return &kafkastrimziiov1beta2.KafkaRebalanceStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaSpec"):
+		return &kafkastrimziiov1beta2.KafkaSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaStatus"):
+		return &kafkastrimziiov1beta2.KafkaStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("KafkaTopic"):
 		return &kafkastrimziiov1beta2.KafkaTopicApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("KafkaTopicSpec"):
@@ -27,6 +159,42 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kafkastrimziiov1beta2.KafkaTopicStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("KafkaTopicStatusConditionsElem"):
 		return &kafkastrimziiov1beta2.KafkaTopicStatusConditionsElemApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUser"):
+		return &kafkastrimziiov1beta2.KafkaUserApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUserAuthentication"):
+		return &kafkastrimziiov1beta2.KafkaUserAuthenticationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUserAuthorization"):
+		return &kafkastrimziiov1beta2.KafkaUserAuthorizationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUserQuotas"):
+		return &kafkastrimziiov1beta2.KafkaUserQuotasApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUserSpec"):
+		return &kafkastrimziiov1beta2.KafkaUserSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("KafkaUserStatus"):
+		return &kafkastrimziiov1beta2.KafkaUserStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ListenerAddress"):
+		return &kafkastrimziiov1beta2.ListenerAddressApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ListenerStatus"):
+		return &kafkastrimziiov1beta2.ListenerStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Logging"):
+		return &kafkastrimziiov1beta2.LoggingApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("MetricsConfig"):
+		return &kafkastrimziiov1beta2.MetricsConfigApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Password"):
+		return &kafkastrimziiov1beta2.PasswordApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PasswordSource"):
+		return &kafkastrimziiov1beta2.PasswordSourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Probe"):
+		return &kafkastrimziiov1beta2.ProbeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Rack"):
+		return &kafkastrimziiov1beta2.RackApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Storage"):
+		return &kafkastrimziiov1beta2.StorageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SystemProperty"):
+		return &kafkastrimziiov1beta2.SystemPropertyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("UsedNodePoolStatus"):
+		return &kafkastrimziiov1beta2.UsedNodePoolStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ZookeeperClusterSpec"):
+		return &kafkastrimziiov1beta2.ZookeeperClusterSpecApplyConfiguration{}
 
 	}
 	return nil
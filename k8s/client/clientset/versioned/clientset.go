@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	http "net/http"
 
+	kafkav1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
 	kafkav1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -17,15 +18,22 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	KafkaV1() kafkav1.KafkaV1Interface
 	KafkaV1beta2() kafkav1beta2.KafkaV1beta2Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	kafkaV1      *kafkav1.KafkaV1Client
 	kafkaV1beta2 *kafkav1beta2.KafkaV1beta2Client
 }
 
+// KafkaV1 retrieves the KafkaV1Client
+func (c *Clientset) KafkaV1() kafkav1.KafkaV1Interface {
+	return c.kafkaV1
+}
+
 // KafkaV1beta2 retrieves the KafkaV1beta2Client
 func (c *Clientset) KafkaV1beta2() kafkav1beta2.KafkaV1beta2Interface {
 	return c.kafkaV1beta2
@@ -75,6 +83,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.kafkaV1, err = kafkav1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.kafkaV1beta2, err = kafkav1beta2.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -100,6 +112,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.kafkaV1 = kafkav1.New(c)
 	cs.kafkaV1beta2 = kafkav1beta2.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
@@ -8,6 +8,8 @@ package fake
 import (
 	applyconfiguration "github.com/bborbe/strimzi/k8s/client/applyconfiguration"
 	clientset "github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+	kafkav1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	fakekafkav1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1/fake"
 	kafkav1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
 	fakekafkav1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -123,6 +125,11 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// KafkaV1 retrieves the KafkaV1Client
+func (c *Clientset) KafkaV1() kafkav1.KafkaV1Interface {
+	return &fakekafkav1.FakeKafkaV1{Fake: &c.Fake}
+}
+
 // KafkaV1beta2 retrieves the KafkaV1beta2Client
 func (c *Clientset) KafkaV1beta2() kafkav1beta2.KafkaV1beta2Interface {
 	return &fakekafkav1beta2.FakeKafkaV1beta2{Fake: &c.Fake}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fake
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/testing"
+
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	kafkav1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// statusSubresources lists the resources that are served with a status subresource.
+var statusSubresources = []schema.GroupVersionResource{
+	kafkav1beta2.SchemeGroupVersion.WithResource("kafkatopics"),
+	kafkav1.SchemeGroupVersion.WithResource("kafkatopics"),
+}
+
+// WithStatusSubresource makes the given clientset honour the spec/status split of
+// resources served with a status subresource, like the API server does:
+//   - Create ignores the status of the object
+//   - Update keeps the stored status
+//   - UpdateStatus only changes the status and keeps the stored spec and metadata
+//
+// Objects passed to NewSimpleClientset or NewClientset are stored as is, which
+// allows seeding fixtures with a status.
+func WithStatusSubresource(c *Clientset) *Clientset {
+	tracker := c.Tracker()
+	for _, gvr := range statusSubresources {
+		c.PrependReactor("create", gvr.Resource, statusCreateReactor(tracker, gvr))
+		c.PrependReactor("update", gvr.Resource, statusUpdateReactor(tracker, gvr))
+	}
+	return c
+}
+
+func statusCreateReactor(
+	tracker testing.ObjectTracker,
+	gvr schema.GroupVersionResource,
+) testing.ReactionFunc {
+	return func(action testing.Action) (bool, runtime.Object, error) {
+		createAction, ok := action.(testing.CreateActionImpl)
+		if !ok || createAction.GetResource() != gvr || createAction.GetSubresource() != "" {
+			return false, nil, nil
+		}
+		obj, err := replaceStatus(createAction.GetObject(), nil)
+		if err != nil {
+			return true, nil, err
+		}
+		objMeta, err := meta.Accessor(obj)
+		if err != nil {
+			return true, nil, err
+		}
+		ns := createAction.GetNamespace()
+		if err := tracker.Create(gvr, obj, ns, createAction.CreateOptions); err != nil {
+			return true, nil, err
+		}
+		result, err := tracker.Get(gvr, ns, objMeta.GetName(), metav1.GetOptions{})
+		return true, result, err
+	}
+}
+
+func statusUpdateReactor(
+	tracker testing.ObjectTracker,
+	gvr schema.GroupVersionResource,
+) testing.ReactionFunc {
+	return func(action testing.Action) (bool, runtime.Object, error) {
+		updateAction, ok := action.(testing.UpdateActionImpl)
+		if !ok || updateAction.GetResource() != gvr {
+			return false, nil, nil
+		}
+		ns := updateAction.GetNamespace()
+		objMeta, err := meta.Accessor(updateAction.GetObject())
+		if err != nil {
+			return true, nil, err
+		}
+		current, err := tracker.Get(gvr, ns, objMeta.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return true, nil, err
+		}
+		var obj runtime.Object
+		switch updateAction.GetSubresource() {
+		case "":
+			obj, err = replaceStatus(updateAction.GetObject(), current)
+		case "status":
+			obj, err = replaceStatus(current, updateAction.GetObject())
+			if err == nil {
+				err = copyResourceVersion(obj, objMeta)
+			}
+		default:
+			return false, nil, nil
+		}
+		if err != nil {
+			return true, nil, err
+		}
+		if err := tracker.Update(gvr, obj, ns, updateAction.UpdateOptions); err != nil {
+			return true, nil, err
+		}
+		result, err := tracker.Get(gvr, ns, objMeta.GetName(), metav1.GetOptions{})
+		return true, result, err
+	}
+}
+
+// replaceStatus returns a copy of target with the status of source.
+// If source is nil or has no status, the status of the copy is removed.
+func replaceStatus(target runtime.Object, source runtime.Object) (runtime.Object, error) {
+	targetContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(target)
+	if err != nil {
+		return nil, err
+	}
+	delete(targetContent, "status")
+	if source != nil {
+		sourceContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(source)
+		if err != nil {
+			return nil, err
+		}
+		if status, ok := sourceContent["status"]; ok {
+			targetContent["status"] = status
+		}
+	}
+	result := reflect.New(reflect.TypeOf(target).Elem()).Interface().(runtime.Object)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(targetContent, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func copyResourceVersion(target runtime.Object, source metav1.Object) error {
+	targetMeta, err := meta.Accessor(target)
+	if err != nil {
+		return err
+	}
+	targetMeta.SetResourceVersion(source.GetResourceVersion())
+	return nil
+}
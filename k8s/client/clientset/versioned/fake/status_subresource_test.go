@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fake_test
+
+import (
+	"context"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("WithStatusSubresource", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var topic *v1beta2.KafkaTopic
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.WithStatusSubresource(fake.NewSimpleClientset())
+		topic = &v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-topic",
+				Namespace: "kafka",
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+			},
+			Status: &v1beta2.KafkaTopicStatus{
+				ObservedGeneration: collection.Ptr(int32(1)),
+			},
+		}
+	})
+
+	get := func() *v1beta2.KafkaTopic {
+		result, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			Get(ctx, "my-topic", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	It("ignores the status on create", func() {
+		result, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			Create(ctx, topic, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+		Expect(result.Status).To(BeNil())
+		Expect(*get().Spec.Partitions).To(Equal(int32(3)))
+		Expect(get().Status).To(BeNil())
+	})
+
+	Context("topic exists with status", func() {
+		BeforeEach(func() {
+			clientset = fake.WithStatusSubresource(fake.NewSimpleClientset(topic))
+		})
+		It("keeps the status on update", func() {
+			update := get()
+			update.Spec.Partitions = collection.Ptr(int32(6))
+			update.Status = nil
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Update(ctx, update, metav1.UpdateOptions{})
+			Expect(err).To(BeNil())
+			Expect(*get().Spec.Partitions).To(Equal(int32(6)))
+			Expect(*get().Status.ObservedGeneration).To(Equal(int32(1)))
+		})
+		It("only changes the status on update status", func() {
+			update := get()
+			update.Spec.Partitions = collection.Ptr(int32(6))
+			update.Labels = map[string]string{"foo": "bar"}
+			update.Status = &v1beta2.KafkaTopicStatus{
+				ObservedGeneration: collection.Ptr(int32(2)),
+				Conditions: []v1beta2.KafkaTopicStatusConditionsElem{
+					{Type: collection.Ptr("Ready"), Status: collection.Ptr("True")},
+				},
+			}
+			result, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				UpdateStatus(ctx, update, metav1.UpdateOptions{})
+			Expect(err).To(BeNil())
+			Expect(*result.Status.ObservedGeneration).To(Equal(int32(2)))
+			Expect(*get().Spec.Partitions).To(Equal(int32(3)))
+			Expect(get().Labels).To(BeEmpty())
+			Expect(*get().Status.ObservedGeneration).To(Equal(int32(2)))
+			Expect(get().Status.Conditions).To(HaveLen(1))
+		})
+	})
+
+	It("returns not found on update status of a missing topic", func() {
+		_, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			UpdateStatus(ctx, topic, metav1.UpdateOptions{})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("honours the status subresource for v1", func() {
+		v1Topic := kafkav1.KafkaTopicFromV1beta2(*topic)
+		clientset = fake.WithStatusSubresource(fake.NewSimpleClientset(&v1Topic))
+		update, err := clientset.KafkaV1().
+			KafkaTopics("kafka").
+			Get(ctx, "my-topic", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		update.Spec.Partitions = collection.Ptr(int32(6))
+		update.Status.ObservedGeneration = collection.Ptr(int64(5))
+		_, err = clientset.KafkaV1().
+			KafkaTopics("kafka").
+			UpdateStatus(ctx, update, metav1.UpdateOptions{})
+		Expect(err).To(BeNil())
+		result, err := clientset.KafkaV1().
+			KafkaTopics("kafka").
+			Get(ctx, "my-topic", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+		Expect(*result.Status.ObservedGeneration).To(Equal(int64(5)))
+	})
+
+	It("does not affect other resources", func() {
+		user := &v1beta2.KafkaUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-user", Namespace: "kafka"},
+			Status:     &v1beta2.KafkaUserStatus{Username: collection.Ptr("CN=my-user")},
+		}
+		result, err := clientset.KafkaV1beta2().
+			KafkaUsers("kafka").
+			Create(ctx, user, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+		Expect(result.Status).NotTo(BeNil())
+	})
+})
@@ -6,6 +6,7 @@
 package scheme
 
 import (
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
 	kafkav1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -18,6 +19,7 @@ var Scheme = runtime.NewScheme()
 var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
+	kafkav1.AddToScheme,
 	kafkav1beta2.AddToScheme,
 }
 
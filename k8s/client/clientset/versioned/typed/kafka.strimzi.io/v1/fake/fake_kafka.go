@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkas implements KafkaInterface
+type fakeKafkas struct {
+	*gentype.FakeClientWithList[*v1.Kafka, *v1.KafkaList]
+	Fake *FakeKafkaV1
+}
+
+func newFakeKafkas(fake *FakeKafkaV1, namespace string) kafkastrimziiov1.KafkaInterface {
+	return &fakeKafkas{
+		gentype.NewFakeClientWithList[*v1.Kafka, *v1.KafkaList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("kafkas"),
+			v1.SchemeGroupVersion.WithKind("Kafka"),
+			func() *v1.Kafka { return &v1.Kafka{} },
+			func() *v1.KafkaList { return &v1.KafkaList{} },
+			func(dst, src *v1.KafkaList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.KafkaList) []*v1.Kafka { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.KafkaList, items []*v1.Kafka) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeKafkaV1 struct {
+	*testing.Fake
+}
+
+func (c *FakeKafkaV1) Kafkas(namespace string) v1.KafkaInterface {
+	return newFakeKafkas(c, namespace)
+}
+
+func (c *FakeKafkaV1) KafkaTopics(namespace string) v1.KafkaTopicInterface {
+	return newFakeKafkaTopics(c, namespace)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeKafkaV1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1"
+	typedkafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaTopics implements KafkaTopicInterface
+type fakeKafkaTopics struct {
+	*gentype.FakeClientWithListAndApply[*v1.KafkaTopic, *v1.KafkaTopicList, *kafkastrimziiov1.KafkaTopicApplyConfiguration]
+	Fake *FakeKafkaV1
+}
+
+func newFakeKafkaTopics(fake *FakeKafkaV1, namespace string) typedkafkastrimziiov1.KafkaTopicInterface {
+	return &fakeKafkaTopics{
+		gentype.NewFakeClientWithListAndApply[*v1.KafkaTopic, *v1.KafkaTopicList, *kafkastrimziiov1.KafkaTopicApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("kafkatopics"),
+			v1.SchemeGroupVersion.WithKind("KafkaTopic"),
+			func() *v1.KafkaTopic { return &v1.KafkaTopic{} },
+			func() *v1.KafkaTopicList { return &v1.KafkaTopicList{} },
+			func(dst, src *v1.KafkaTopicList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.KafkaTopicList) []*v1.KafkaTopic { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.KafkaTopicList, items []*v1.KafkaTopic) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
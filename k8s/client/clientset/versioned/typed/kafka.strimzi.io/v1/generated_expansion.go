@@ -0,0 +1,10 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+type KafkaExpansion interface{}
+
+type KafkaTopicExpansion interface{}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkasGetter has a method to return a KafkaInterface.
+// A group's client should implement this interface.
+type KafkasGetter interface {
+	Kafkas(namespace string) KafkaInterface
+}
+
+// KafkaInterface has methods to work with Kafka resources.
+type KafkaInterface interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*kafkastrimziiov1.Kafka, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*kafkastrimziiov1.KafkaList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	KafkaExpansion
+}
+
+// kafkas implements KafkaInterface
+type kafkas struct {
+	*gentype.ClientWithList[*kafkastrimziiov1.Kafka, *kafkastrimziiov1.KafkaList]
+}
+
+// newKafkas returns a Kafkas
+func newKafkas(c *KafkaV1Client, namespace string) *kafkas {
+	return &kafkas{
+		gentype.NewClientWithList[*kafkastrimziiov1.Kafka, *kafkastrimziiov1.KafkaList](
+			"kafkas",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1.Kafka { return &kafkastrimziiov1.Kafka{} },
+			func() *kafkastrimziiov1.KafkaList { return &kafkastrimziiov1.KafkaList{} },
+		),
+	}
+}
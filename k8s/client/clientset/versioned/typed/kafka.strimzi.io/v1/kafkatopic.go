@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	applyconfigurationkafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaTopicsGetter has a method to return a KafkaTopicInterface.
+// A group's client should implement this interface.
+type KafkaTopicsGetter interface {
+	KafkaTopics(namespace string) KafkaTopicInterface
+}
+
+// KafkaTopicInterface has methods to work with KafkaTopic resources.
+type KafkaTopicInterface interface {
+	Create(ctx context.Context, kafkaTopic *kafkastrimziiov1.KafkaTopic, opts metav1.CreateOptions) (*kafkastrimziiov1.KafkaTopic, error)
+	Update(ctx context.Context, kafkaTopic *kafkastrimziiov1.KafkaTopic, opts metav1.UpdateOptions) (*kafkastrimziiov1.KafkaTopic, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, kafkaTopic *kafkastrimziiov1.KafkaTopic, opts metav1.UpdateOptions) (*kafkastrimziiov1.KafkaTopic, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*kafkastrimziiov1.KafkaTopic, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*kafkastrimziiov1.KafkaTopicList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *kafkastrimziiov1.KafkaTopic, err error)
+	Apply(ctx context.Context, kafkaTopic *applyconfigurationkafkastrimziiov1.KafkaTopicApplyConfiguration, opts metav1.ApplyOptions) (result *kafkastrimziiov1.KafkaTopic, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, kafkaTopic *applyconfigurationkafkastrimziiov1.KafkaTopicApplyConfiguration, opts metav1.ApplyOptions) (result *kafkastrimziiov1.KafkaTopic, err error)
+	KafkaTopicExpansion
+}
+
+// kafkaTopics implements KafkaTopicInterface
+type kafkaTopics struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1.KafkaTopic, *kafkastrimziiov1.KafkaTopicList, *applyconfigurationkafkastrimziiov1.KafkaTopicApplyConfiguration]
+}
+
+// newKafkaTopics returns a KafkaTopics
+func newKafkaTopics(c *KafkaV1Client, namespace string) *kafkaTopics {
+	return &kafkaTopics{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1.KafkaTopic, *kafkastrimziiov1.KafkaTopicList, *applyconfigurationkafkastrimziiov1.KafkaTopicApplyConfiguration](
+			"kafkatopics",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1.KafkaTopic { return &kafkastrimziiov1.KafkaTopic{} },
+			func() *kafkastrimziiov1.KafkaTopicList { return &kafkastrimziiov1.KafkaTopicList{} },
+		),
+	}
+}
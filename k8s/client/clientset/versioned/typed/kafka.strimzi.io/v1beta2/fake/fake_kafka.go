@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkas implements KafkaInterface
+type fakeKafkas struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.Kafka, *v1beta2.KafkaList, *kafkastrimziiov1beta2.KafkaApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkas(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaInterface {
+	return &fakeKafkas{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.Kafka, *v1beta2.KafkaList, *kafkastrimziiov1beta2.KafkaApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkas"),
+			v1beta2.SchemeGroupVersion.WithKind("Kafka"),
+			func() *v1beta2.Kafka { return &v1beta2.Kafka{} },
+			func() *v1beta2.KafkaList { return &v1beta2.KafkaList{} },
+			func(dst, src *v1beta2.KafkaList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaList) []*v1beta2.Kafka { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta2.KafkaList, items []*v1beta2.Kafka) { list.Items = gentype.FromPointerSlice(items) },
+		),
+		fake,
+	}
+}
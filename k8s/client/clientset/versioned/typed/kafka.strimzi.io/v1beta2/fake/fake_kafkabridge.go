@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaBridges implements KafkaBridgeInterface
+type fakeKafkaBridges struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaBridge, *v1beta2.KafkaBridgeList, *kafkastrimziiov1beta2.KafkaBridgeApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaBridges(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaBridgeInterface {
+	return &fakeKafkaBridges{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaBridge, *v1beta2.KafkaBridgeList, *kafkastrimziiov1beta2.KafkaBridgeApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkabridges"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaBridge"),
+			func() *v1beta2.KafkaBridge { return &v1beta2.KafkaBridge{} },
+			func() *v1beta2.KafkaBridgeList { return &v1beta2.KafkaBridgeList{} },
+			func(dst, src *v1beta2.KafkaBridgeList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaBridgeList) []*v1beta2.KafkaBridge { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta2.KafkaBridgeList, items []*v1beta2.KafkaBridge) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaConnects implements KafkaConnectInterface
+type fakeKafkaConnects struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaConnect, *v1beta2.KafkaConnectList, *kafkastrimziiov1beta2.KafkaConnectApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaConnects(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaConnectInterface {
+	return &fakeKafkaConnects{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaConnect, *v1beta2.KafkaConnectList, *kafkastrimziiov1beta2.KafkaConnectApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkaconnects"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaConnect"),
+			func() *v1beta2.KafkaConnect { return &v1beta2.KafkaConnect{} },
+			func() *v1beta2.KafkaConnectList { return &v1beta2.KafkaConnectList{} },
+			func(dst, src *v1beta2.KafkaConnectList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaConnectList) []*v1beta2.KafkaConnect {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.KafkaConnectList, items []*v1beta2.KafkaConnect) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaConnectors implements KafkaConnectorInterface
+type fakeKafkaConnectors struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaConnector, *v1beta2.KafkaConnectorList, *kafkastrimziiov1beta2.KafkaConnectorApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaConnectors(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaConnectorInterface {
+	return &fakeKafkaConnectors{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaConnector, *v1beta2.KafkaConnectorList, *kafkastrimziiov1beta2.KafkaConnectorApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkaconnectors"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaConnector"),
+			func() *v1beta2.KafkaConnector { return &v1beta2.KafkaConnector{} },
+			func() *v1beta2.KafkaConnectorList { return &v1beta2.KafkaConnectorList{} },
+			func(dst, src *v1beta2.KafkaConnectorList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaConnectorList) []*v1beta2.KafkaConnector {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.KafkaConnectorList, items []*v1beta2.KafkaConnector) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaMirrorMaker2s implements KafkaMirrorMaker2Interface
+type fakeKafkaMirrorMaker2s struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaMirrorMaker2, *v1beta2.KafkaMirrorMaker2List, *kafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaMirrorMaker2s(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaMirrorMaker2Interface {
+	return &fakeKafkaMirrorMaker2s{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaMirrorMaker2, *v1beta2.KafkaMirrorMaker2List, *kafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkamirrormaker2s"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaMirrorMaker2"),
+			func() *v1beta2.KafkaMirrorMaker2 { return &v1beta2.KafkaMirrorMaker2{} },
+			func() *v1beta2.KafkaMirrorMaker2List { return &v1beta2.KafkaMirrorMaker2List{} },
+			func(dst, src *v1beta2.KafkaMirrorMaker2List) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaMirrorMaker2List) []*v1beta2.KafkaMirrorMaker2 {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.KafkaMirrorMaker2List, items []*v1beta2.KafkaMirrorMaker2) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaNodePools implements KafkaNodePoolInterface
+type fakeKafkaNodePools struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaNodePool, *v1beta2.KafkaNodePoolList, *kafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaNodePools(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaNodePoolInterface {
+	return &fakeKafkaNodePools{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaNodePool, *v1beta2.KafkaNodePoolList, *kafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkanodepools"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaNodePool"),
+			func() *v1beta2.KafkaNodePool { return &v1beta2.KafkaNodePool{} },
+			func() *v1beta2.KafkaNodePoolList { return &v1beta2.KafkaNodePoolList{} },
+			func(dst, src *v1beta2.KafkaNodePoolList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaNodePoolList) []*v1beta2.KafkaNodePool {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.KafkaNodePoolList, items []*v1beta2.KafkaNodePool) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaRebalances implements KafkaRebalanceInterface
+type fakeKafkaRebalances struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaRebalance, *v1beta2.KafkaRebalanceList, *kafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaRebalances(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaRebalanceInterface {
+	return &fakeKafkaRebalances{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaRebalance, *v1beta2.KafkaRebalanceList, *kafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkarebalances"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaRebalance"),
+			func() *v1beta2.KafkaRebalance { return &v1beta2.KafkaRebalance{} },
+			func() *v1beta2.KafkaRebalanceList { return &v1beta2.KafkaRebalanceList{} },
+			func(dst, src *v1beta2.KafkaRebalanceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaRebalanceList) []*v1beta2.KafkaRebalance {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta2.KafkaRebalanceList, items []*v1beta2.KafkaRebalance) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	typedkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeKafkaUsers implements KafkaUserInterface
+type fakeKafkaUsers struct {
+	*gentype.FakeClientWithListAndApply[*v1beta2.KafkaUser, *v1beta2.KafkaUserList, *kafkastrimziiov1beta2.KafkaUserApplyConfiguration]
+	Fake *FakeKafkaV1beta2
+}
+
+func newFakeKafkaUsers(fake *FakeKafkaV1beta2, namespace string) typedkafkastrimziiov1beta2.KafkaUserInterface {
+	return &fakeKafkaUsers{
+		gentype.NewFakeClientWithListAndApply[*v1beta2.KafkaUser, *v1beta2.KafkaUserList, *kafkastrimziiov1beta2.KafkaUserApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta2.SchemeGroupVersion.WithResource("kafkausers"),
+			v1beta2.SchemeGroupVersion.WithKind("KafkaUser"),
+			func() *v1beta2.KafkaUser { return &v1beta2.KafkaUser{} },
+			func() *v1beta2.KafkaUserList { return &v1beta2.KafkaUserList{} },
+			func(dst, src *v1beta2.KafkaUserList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta2.KafkaUserList) []*v1beta2.KafkaUser { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta2.KafkaUserList, items []*v1beta2.KafkaUser) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkasGetter has a method to return a KafkaInterface.
+// A group's client should implement this interface.
+type KafkasGetter interface {
+	Kafkas(namespace string) KafkaInterface
+}
+
+// KafkaInterface has methods to work with Kafka resources.
+type KafkaInterface interface {
+	Create(ctx context.Context, kafka *kafkastrimziiov1beta2.Kafka, opts v1.CreateOptions) (*kafkastrimziiov1beta2.Kafka, error)
+	Update(ctx context.Context, kafka *kafkastrimziiov1beta2.Kafka, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.Kafka, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.Kafka, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.Kafka, err error)
+	Apply(ctx context.Context, kafka *applyconfigurationkafkastrimziiov1beta2.KafkaApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.Kafka, err error)
+	KafkaExpansion
+}
+
+// kafkas implements KafkaInterface
+type kafkas struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.Kafka, *kafkastrimziiov1beta2.KafkaList, *applyconfigurationkafkastrimziiov1beta2.KafkaApplyConfiguration]
+}
+
+// newKafkas returns a Kafkas
+func newKafkas(c *KafkaV1beta2Client, namespace string) *kafkas {
+	return &kafkas{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.Kafka, *kafkastrimziiov1beta2.KafkaList, *applyconfigurationkafkastrimziiov1beta2.KafkaApplyConfiguration](
+			"kafkas",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.Kafka { return &kafkastrimziiov1beta2.Kafka{} },
+			func() *kafkastrimziiov1beta2.KafkaList { return &kafkastrimziiov1beta2.KafkaList{} },
+		),
+	}
+}
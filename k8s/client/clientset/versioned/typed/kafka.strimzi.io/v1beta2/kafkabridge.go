@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaBridgesGetter has a method to return a KafkaBridgeInterface.
+// A group's client should implement this interface.
+type KafkaBridgesGetter interface {
+	KafkaBridges(namespace string) KafkaBridgeInterface
+}
+
+// KafkaBridgeInterface has methods to work with KafkaBridge resources.
+type KafkaBridgeInterface interface {
+	Create(ctx context.Context, kafkaBridge *kafkastrimziiov1beta2.KafkaBridge, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaBridge, error)
+	Update(ctx context.Context, kafkaBridge *kafkastrimziiov1beta2.KafkaBridge, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaBridge, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaBridge, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaBridgeList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaBridge, err error)
+	Apply(ctx context.Context, kafkaBridge *applyconfigurationkafkastrimziiov1beta2.KafkaBridgeApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaBridge, err error)
+	KafkaBridgeExpansion
+}
+
+// kafkaBridges implements KafkaBridgeInterface
+type kafkaBridges struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaBridge, *kafkastrimziiov1beta2.KafkaBridgeList, *applyconfigurationkafkastrimziiov1beta2.KafkaBridgeApplyConfiguration]
+}
+
+// newKafkaBridges returns a KafkaBridges
+func newKafkaBridges(c *KafkaV1beta2Client, namespace string) *kafkaBridges {
+	return &kafkaBridges{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaBridge, *kafkastrimziiov1beta2.KafkaBridgeList, *applyconfigurationkafkastrimziiov1beta2.KafkaBridgeApplyConfiguration](
+			"kafkabridges",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaBridge { return &kafkastrimziiov1beta2.KafkaBridge{} },
+			func() *kafkastrimziiov1beta2.KafkaBridgeList { return &kafkastrimziiov1beta2.KafkaBridgeList{} },
+		),
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaConnectsGetter has a method to return a KafkaConnectInterface.
+// A group's client should implement this interface.
+type KafkaConnectsGetter interface {
+	KafkaConnects(namespace string) KafkaConnectInterface
+}
+
+// KafkaConnectInterface has methods to work with KafkaConnect resources.
+type KafkaConnectInterface interface {
+	Create(ctx context.Context, kafkaConnect *kafkastrimziiov1beta2.KafkaConnect, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaConnect, error)
+	Update(ctx context.Context, kafkaConnect *kafkastrimziiov1beta2.KafkaConnect, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaConnect, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaConnect, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaConnectList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaConnect, err error)
+	Apply(ctx context.Context, kafkaConnect *applyconfigurationkafkastrimziiov1beta2.KafkaConnectApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaConnect, err error)
+	KafkaConnectExpansion
+}
+
+// kafkaConnects implements KafkaConnectInterface
+type kafkaConnects struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaConnect, *kafkastrimziiov1beta2.KafkaConnectList, *applyconfigurationkafkastrimziiov1beta2.KafkaConnectApplyConfiguration]
+}
+
+// newKafkaConnects returns a KafkaConnects
+func newKafkaConnects(c *KafkaV1beta2Client, namespace string) *kafkaConnects {
+	return &kafkaConnects{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaConnect, *kafkastrimziiov1beta2.KafkaConnectList, *applyconfigurationkafkastrimziiov1beta2.KafkaConnectApplyConfiguration](
+			"kafkaconnects",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaConnect { return &kafkastrimziiov1beta2.KafkaConnect{} },
+			func() *kafkastrimziiov1beta2.KafkaConnectList { return &kafkastrimziiov1beta2.KafkaConnectList{} },
+		),
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaConnectorsGetter has a method to return a KafkaConnectorInterface.
+// A group's client should implement this interface.
+type KafkaConnectorsGetter interface {
+	KafkaConnectors(namespace string) KafkaConnectorInterface
+}
+
+// KafkaConnectorInterface has methods to work with KafkaConnector resources.
+type KafkaConnectorInterface interface {
+	Create(ctx context.Context, kafkaConnector *kafkastrimziiov1beta2.KafkaConnector, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaConnector, error)
+	Update(ctx context.Context, kafkaConnector *kafkastrimziiov1beta2.KafkaConnector, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaConnector, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaConnector, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaConnectorList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaConnector, err error)
+	Apply(ctx context.Context, kafkaConnector *applyconfigurationkafkastrimziiov1beta2.KafkaConnectorApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaConnector, err error)
+	KafkaConnectorExpansion
+}
+
+// kafkaConnectors implements KafkaConnectorInterface
+type kafkaConnectors struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaConnector, *kafkastrimziiov1beta2.KafkaConnectorList, *applyconfigurationkafkastrimziiov1beta2.KafkaConnectorApplyConfiguration]
+}
+
+// newKafkaConnectors returns a KafkaConnectors
+func newKafkaConnectors(c *KafkaV1beta2Client, namespace string) *kafkaConnectors {
+	return &kafkaConnectors{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaConnector, *kafkastrimziiov1beta2.KafkaConnectorList, *applyconfigurationkafkastrimziiov1beta2.KafkaConnectorApplyConfiguration](
+			"kafkaconnectors",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaConnector { return &kafkastrimziiov1beta2.KafkaConnector{} },
+			func() *kafkastrimziiov1beta2.KafkaConnectorList { return &kafkastrimziiov1beta2.KafkaConnectorList{} },
+		),
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaMirrorMaker2sGetter has a method to return a KafkaMirrorMaker2Interface.
+// A group's client should implement this interface.
+type KafkaMirrorMaker2sGetter interface {
+	KafkaMirrorMaker2s(namespace string) KafkaMirrorMaker2Interface
+}
+
+// KafkaMirrorMaker2Interface has methods to work with KafkaMirrorMaker2 resources.
+type KafkaMirrorMaker2Interface interface {
+	Create(ctx context.Context, kafkaMirrorMaker2 *kafkastrimziiov1beta2.KafkaMirrorMaker2, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaMirrorMaker2, error)
+	Update(ctx context.Context, kafkaMirrorMaker2 *kafkastrimziiov1beta2.KafkaMirrorMaker2, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaMirrorMaker2, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaMirrorMaker2, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaMirrorMaker2List, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaMirrorMaker2, err error)
+	Apply(ctx context.Context, kafkaMirrorMaker2 *applyconfigurationkafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaMirrorMaker2, err error)
+	KafkaMirrorMaker2Expansion
+}
+
+// kafkaMirrorMaker2s implements KafkaMirrorMaker2Interface
+type kafkaMirrorMaker2s struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaMirrorMaker2, *kafkastrimziiov1beta2.KafkaMirrorMaker2List, *applyconfigurationkafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration]
+}
+
+// newKafkaMirrorMaker2s returns a KafkaMirrorMaker2s
+func newKafkaMirrorMaker2s(c *KafkaV1beta2Client, namespace string) *kafkaMirrorMaker2s {
+	return &kafkaMirrorMaker2s{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaMirrorMaker2, *kafkastrimziiov1beta2.KafkaMirrorMaker2List, *applyconfigurationkafkastrimziiov1beta2.KafkaMirrorMaker2ApplyConfiguration](
+			"kafkamirrormaker2s",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaMirrorMaker2 { return &kafkastrimziiov1beta2.KafkaMirrorMaker2{} },
+			func() *kafkastrimziiov1beta2.KafkaMirrorMaker2List {
+				return &kafkastrimziiov1beta2.KafkaMirrorMaker2List{}
+			},
+		),
+	}
+}
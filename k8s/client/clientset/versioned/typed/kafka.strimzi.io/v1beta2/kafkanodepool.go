@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaNodePoolsGetter has a method to return a KafkaNodePoolInterface.
+// A group's client should implement this interface.
+type KafkaNodePoolsGetter interface {
+	KafkaNodePools(namespace string) KafkaNodePoolInterface
+}
+
+// KafkaNodePoolInterface has methods to work with KafkaNodePool resources.
+type KafkaNodePoolInterface interface {
+	Create(ctx context.Context, kafkaNodePool *kafkastrimziiov1beta2.KafkaNodePool, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaNodePool, error)
+	Update(ctx context.Context, kafkaNodePool *kafkastrimziiov1beta2.KafkaNodePool, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaNodePool, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaNodePool, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaNodePoolList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaNodePool, err error)
+	Apply(ctx context.Context, kafkaNodePool *applyconfigurationkafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaNodePool, err error)
+	KafkaNodePoolExpansion
+}
+
+// kafkaNodePools implements KafkaNodePoolInterface
+type kafkaNodePools struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaNodePool, *kafkastrimziiov1beta2.KafkaNodePoolList, *applyconfigurationkafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration]
+}
+
+// newKafkaNodePools returns a KafkaNodePools
+func newKafkaNodePools(c *KafkaV1beta2Client, namespace string) *kafkaNodePools {
+	return &kafkaNodePools{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaNodePool, *kafkastrimziiov1beta2.KafkaNodePoolList, *applyconfigurationkafkastrimziiov1beta2.KafkaNodePoolApplyConfiguration](
+			"kafkanodepools",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaNodePool { return &kafkastrimziiov1beta2.KafkaNodePool{} },
+			func() *kafkastrimziiov1beta2.KafkaNodePoolList { return &kafkastrimziiov1beta2.KafkaNodePoolList{} },
+		),
+	}
+}
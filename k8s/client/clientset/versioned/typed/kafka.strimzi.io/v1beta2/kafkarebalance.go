@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaRebalancesGetter has a method to return a KafkaRebalanceInterface.
+// A group's client should implement this interface.
+type KafkaRebalancesGetter interface {
+	KafkaRebalances(namespace string) KafkaRebalanceInterface
+}
+
+// KafkaRebalanceInterface has methods to work with KafkaRebalance resources.
+type KafkaRebalanceInterface interface {
+	Create(ctx context.Context, kafkaRebalance *kafkastrimziiov1beta2.KafkaRebalance, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaRebalance, error)
+	Update(ctx context.Context, kafkaRebalance *kafkastrimziiov1beta2.KafkaRebalance, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaRebalance, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaRebalance, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaRebalanceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaRebalance, err error)
+	Apply(ctx context.Context, kafkaRebalance *applyconfigurationkafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaRebalance, err error)
+	KafkaRebalanceExpansion
+}
+
+// kafkaRebalances implements KafkaRebalanceInterface
+type kafkaRebalances struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaRebalance, *kafkastrimziiov1beta2.KafkaRebalanceList, *applyconfigurationkafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration]
+}
+
+// newKafkaRebalances returns a KafkaRebalances
+func newKafkaRebalances(c *KafkaV1beta2Client, namespace string) *kafkaRebalances {
+	return &kafkaRebalances{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaRebalance, *kafkastrimziiov1beta2.KafkaRebalanceList, *applyconfigurationkafkastrimziiov1beta2.KafkaRebalanceApplyConfiguration](
+			"kafkarebalances",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaRebalance { return &kafkastrimziiov1beta2.KafkaRebalance{} },
+			func() *kafkastrimziiov1beta2.KafkaRebalanceList { return &kafkastrimziiov1beta2.KafkaRebalanceList{} },
+		),
+	}
+}
@@ -27,6 +27,8 @@ type KafkaTopicsGetter interface {
 type KafkaTopicInterface interface {
 	Create(ctx context.Context, kafkaTopic *kafkastrimziiov1beta2.KafkaTopic, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaTopic, error)
 	Update(ctx context.Context, kafkaTopic *kafkastrimziiov1beta2.KafkaTopic, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaTopic, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, kafkaTopic *kafkastrimziiov1beta2.KafkaTopic, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaTopic, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaTopic, error)
@@ -34,6 +36,8 @@ type KafkaTopicInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaTopic, err error)
 	Apply(ctx context.Context, kafkaTopic *applyconfigurationkafkastrimziiov1beta2.KafkaTopicApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaTopic, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, kafkaTopic *applyconfigurationkafkastrimziiov1beta2.KafkaTopicApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaTopic, err error)
 	KafkaTopicExpansion
 }
 
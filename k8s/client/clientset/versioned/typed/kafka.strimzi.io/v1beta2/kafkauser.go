@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	context "context"
+
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyconfigurationkafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	scheme "github.com/bborbe/strimzi/k8s/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// KafkaUsersGetter has a method to return a KafkaUserInterface.
+// A group's client should implement this interface.
+type KafkaUsersGetter interface {
+	KafkaUsers(namespace string) KafkaUserInterface
+}
+
+// KafkaUserInterface has methods to work with KafkaUser resources.
+type KafkaUserInterface interface {
+	Create(ctx context.Context, kafkaUser *kafkastrimziiov1beta2.KafkaUser, opts v1.CreateOptions) (*kafkastrimziiov1beta2.KafkaUser, error)
+	Update(ctx context.Context, kafkaUser *kafkastrimziiov1beta2.KafkaUser, opts v1.UpdateOptions) (*kafkastrimziiov1beta2.KafkaUser, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*kafkastrimziiov1beta2.KafkaUser, error)
+	List(ctx context.Context, opts v1.ListOptions) (*kafkastrimziiov1beta2.KafkaUserList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kafkastrimziiov1beta2.KafkaUser, err error)
+	Apply(ctx context.Context, kafkaUser *applyconfigurationkafkastrimziiov1beta2.KafkaUserApplyConfiguration, opts v1.ApplyOptions) (result *kafkastrimziiov1beta2.KafkaUser, err error)
+	KafkaUserExpansion
+}
+
+// kafkaUsers implements KafkaUserInterface
+type kafkaUsers struct {
+	*gentype.ClientWithListAndApply[*kafkastrimziiov1beta2.KafkaUser, *kafkastrimziiov1beta2.KafkaUserList, *applyconfigurationkafkastrimziiov1beta2.KafkaUserApplyConfiguration]
+}
+
+// newKafkaUsers returns a KafkaUsers
+func newKafkaUsers(c *KafkaV1beta2Client, namespace string) *kafkaUsers {
+	return &kafkaUsers{
+		gentype.NewClientWithListAndApply[*kafkastrimziiov1beta2.KafkaUser, *kafkastrimziiov1beta2.KafkaUserList, *applyconfigurationkafkastrimziiov1beta2.KafkaUserApplyConfiguration](
+			"kafkausers",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *kafkastrimziiov1beta2.KafkaUser { return &kafkastrimziiov1beta2.KafkaUser{} },
+			func() *kafkastrimziiov1beta2.KafkaUserList { return &kafkastrimziiov1beta2.KafkaUserList{} },
+		),
+	}
+}
@@ -8,6 +8,7 @@ package externalversions
 import (
 	fmt "fmt"
 
+	v1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
 	v1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
@@ -39,9 +40,31 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=kafka, Version=v1beta2
+	// Group=kafka.strimzi.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("kafkas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1().Kafkas().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("kafkatopics"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1().KafkaTopics().Informer()}, nil
+
+		// Group=kafka.strimzi.io, Version=v1beta2
+	case v1beta2.SchemeGroupVersion.WithResource("kafkas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().Kafkas().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkabridges"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaBridges().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkaconnects"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaConnects().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkaconnectors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaConnectors().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkamirrormaker2s"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaMirrorMaker2s().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkanodepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaNodePools().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkarebalances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaRebalances().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("kafkatopics"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaTopics().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("kafkausers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kafka().V1beta2().KafkaUsers().Informer()}, nil
 
 	}
 
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	apiskafkastrimziiov1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	versioned "github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+	internalinterfaces "github.com/bborbe/strimzi/k8s/client/informers/externalversions/internalinterfaces"
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/client/listers/kafka.strimzi.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaInformer provides access to a shared informer and lister for
+// Kafkas.
+type KafkaInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() kafkastrimziiov1.KafkaLister
+}
+
+type kafkaInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewKafkaInformer constructs a new informer for Kafka type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewKafkaInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredKafkaInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredKafkaInformer constructs a new informer for Kafka type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredKafkaInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KafkaV1().Kafkas(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KafkaV1().Kafkas(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KafkaV1().Kafkas(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KafkaV1().Kafkas(namespace).Watch(ctx, options)
+			},
+		}, client),
+		&apiskafkastrimziiov1.Kafka{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *kafkaInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredKafkaInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *kafkaInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apiskafkastrimziiov1.Kafka{}, f.defaultInformer)
+}
+
+func (f *kafkaInformer) Lister() kafkastrimziiov1.KafkaLister {
+	return kafkastrimziiov1.NewKafkaLister(f.Informer().GetIndexer())
+}
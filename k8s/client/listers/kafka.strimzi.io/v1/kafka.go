@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	kafkastrimziiov1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaLister helps list Kafkas.
+// All objects returned here must be treated as read-only.
+type KafkaLister interface {
+	// List lists all Kafkas in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1.Kafka, err error)
+	// Kafkas returns an object that can list and get Kafkas.
+	Kafkas(namespace string) KafkaNamespaceLister
+	KafkaListerExpansion
+}
+
+// kafkaLister implements the KafkaLister interface.
+type kafkaLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1.Kafka]
+}
+
+// NewKafkaLister returns a new KafkaLister.
+func NewKafkaLister(indexer cache.Indexer) KafkaLister {
+	return &kafkaLister{listers.New[*kafkastrimziiov1.Kafka](indexer, kafkastrimziiov1.Resource("kafka"))}
+}
+
+// Kafkas returns an object that can list and get Kafkas.
+func (s *kafkaLister) Kafkas(namespace string) KafkaNamespaceLister {
+	return kafkaNamespaceLister{listers.NewNamespaced[*kafkastrimziiov1.Kafka](s.ResourceIndexer, namespace)}
+}
+
+// KafkaNamespaceLister helps list and get Kafkas.
+// All objects returned here must be treated as read-only.
+type KafkaNamespaceLister interface {
+	// List lists all Kafkas in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1.Kafka, err error)
+	// Get retrieves the Kafka from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kafkastrimziiov1.Kafka, error)
+	KafkaNamespaceListerExpansion
+}
+
+// kafkaNamespaceLister implements the KafkaNamespaceLister
+// interface.
+type kafkaNamespaceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1.Kafka]
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaMirrorMaker2Lister helps list KafkaMirrorMaker2s.
+// All objects returned here must be treated as read-only.
+type KafkaMirrorMaker2Lister interface {
+	// List lists all KafkaMirrorMaker2s in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaMirrorMaker2, err error)
+	// KafkaMirrorMaker2s returns an object that can list and get KafkaMirrorMaker2s.
+	KafkaMirrorMaker2s(namespace string) KafkaMirrorMaker2NamespaceLister
+	KafkaMirrorMaker2ListerExpansion
+}
+
+// kafkaMirrorMaker2Lister implements the KafkaMirrorMaker2Lister interface.
+type kafkaMirrorMaker2Lister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaMirrorMaker2]
+}
+
+// NewKafkaMirrorMaker2Lister returns a new KafkaMirrorMaker2Lister.
+func NewKafkaMirrorMaker2Lister(indexer cache.Indexer) KafkaMirrorMaker2Lister {
+	return &kafkaMirrorMaker2Lister{listers.New[*kafkastrimziiov1beta2.KafkaMirrorMaker2](indexer, kafkastrimziiov1beta2.Resource("kafkamirrormaker2"))}
+}
+
+// KafkaMirrorMaker2s returns an object that can list and get KafkaMirrorMaker2s.
+func (s *kafkaMirrorMaker2Lister) KafkaMirrorMaker2s(namespace string) KafkaMirrorMaker2NamespaceLister {
+	return kafkaMirrorMaker2NamespaceLister{listers.NewNamespaced[*kafkastrimziiov1beta2.KafkaMirrorMaker2](s.ResourceIndexer, namespace)}
+}
+
+// KafkaMirrorMaker2NamespaceLister helps list and get KafkaMirrorMaker2s.
+// All objects returned here must be treated as read-only.
+type KafkaMirrorMaker2NamespaceLister interface {
+	// List lists all KafkaMirrorMaker2s in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaMirrorMaker2, err error)
+	// Get retrieves the KafkaMirrorMaker2 from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kafkastrimziiov1beta2.KafkaMirrorMaker2, error)
+	KafkaMirrorMaker2NamespaceListerExpansion
+}
+
+// kafkaMirrorMaker2NamespaceLister implements the KafkaMirrorMaker2NamespaceLister
+// interface.
+type kafkaMirrorMaker2NamespaceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaMirrorMaker2]
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaNodePoolLister helps list KafkaNodePools.
+// All objects returned here must be treated as read-only.
+type KafkaNodePoolLister interface {
+	// List lists all KafkaNodePools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaNodePool, err error)
+	// KafkaNodePools returns an object that can list and get KafkaNodePools.
+	KafkaNodePools(namespace string) KafkaNodePoolNamespaceLister
+	KafkaNodePoolListerExpansion
+}
+
+// kafkaNodePoolLister implements the KafkaNodePoolLister interface.
+type kafkaNodePoolLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaNodePool]
+}
+
+// NewKafkaNodePoolLister returns a new KafkaNodePoolLister.
+func NewKafkaNodePoolLister(indexer cache.Indexer) KafkaNodePoolLister {
+	return &kafkaNodePoolLister{listers.New[*kafkastrimziiov1beta2.KafkaNodePool](indexer, kafkastrimziiov1beta2.Resource("kafkanodepool"))}
+}
+
+// KafkaNodePools returns an object that can list and get KafkaNodePools.
+func (s *kafkaNodePoolLister) KafkaNodePools(namespace string) KafkaNodePoolNamespaceLister {
+	return kafkaNodePoolNamespaceLister{listers.NewNamespaced[*kafkastrimziiov1beta2.KafkaNodePool](s.ResourceIndexer, namespace)}
+}
+
+// KafkaNodePoolNamespaceLister helps list and get KafkaNodePools.
+// All objects returned here must be treated as read-only.
+type KafkaNodePoolNamespaceLister interface {
+	// List lists all KafkaNodePools in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaNodePool, err error)
+	// Get retrieves the KafkaNodePool from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kafkastrimziiov1beta2.KafkaNodePool, error)
+	KafkaNodePoolNamespaceListerExpansion
+}
+
+// kafkaNodePoolNamespaceLister implements the KafkaNodePoolNamespaceLister
+// interface.
+type kafkaNodePoolNamespaceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaNodePool]
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaRebalanceLister helps list KafkaRebalances.
+// All objects returned here must be treated as read-only.
+type KafkaRebalanceLister interface {
+	// List lists all KafkaRebalances in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaRebalance, err error)
+	// KafkaRebalances returns an object that can list and get KafkaRebalances.
+	KafkaRebalances(namespace string) KafkaRebalanceNamespaceLister
+	KafkaRebalanceListerExpansion
+}
+
+// kafkaRebalanceLister implements the KafkaRebalanceLister interface.
+type kafkaRebalanceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaRebalance]
+}
+
+// NewKafkaRebalanceLister returns a new KafkaRebalanceLister.
+func NewKafkaRebalanceLister(indexer cache.Indexer) KafkaRebalanceLister {
+	return &kafkaRebalanceLister{listers.New[*kafkastrimziiov1beta2.KafkaRebalance](indexer, kafkastrimziiov1beta2.Resource("kafkarebalance"))}
+}
+
+// KafkaRebalances returns an object that can list and get KafkaRebalances.
+func (s *kafkaRebalanceLister) KafkaRebalances(namespace string) KafkaRebalanceNamespaceLister {
+	return kafkaRebalanceNamespaceLister{listers.NewNamespaced[*kafkastrimziiov1beta2.KafkaRebalance](s.ResourceIndexer, namespace)}
+}
+
+// KafkaRebalanceNamespaceLister helps list and get KafkaRebalances.
+// All objects returned here must be treated as read-only.
+type KafkaRebalanceNamespaceLister interface {
+	// List lists all KafkaRebalances in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaRebalance, err error)
+	// Get retrieves the KafkaRebalance from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kafkastrimziiov1beta2.KafkaRebalance, error)
+	KafkaRebalanceNamespaceListerExpansion
+}
+
+// kafkaRebalanceNamespaceLister implements the KafkaRebalanceNamespaceLister
+// interface.
+type kafkaRebalanceNamespaceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaRebalance]
+}
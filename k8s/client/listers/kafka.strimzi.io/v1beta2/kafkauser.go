@@ -0,0 +1,57 @@
+// Copyright (c) 2025 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	kafkastrimziiov1beta2 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// KafkaUserLister helps list KafkaUsers.
+// All objects returned here must be treated as read-only.
+type KafkaUserLister interface {
+	// List lists all KafkaUsers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaUser, err error)
+	// KafkaUsers returns an object that can list and get KafkaUsers.
+	KafkaUsers(namespace string) KafkaUserNamespaceLister
+	KafkaUserListerExpansion
+}
+
+// kafkaUserLister implements the KafkaUserLister interface.
+type kafkaUserLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaUser]
+}
+
+// NewKafkaUserLister returns a new KafkaUserLister.
+func NewKafkaUserLister(indexer cache.Indexer) KafkaUserLister {
+	return &kafkaUserLister{listers.New[*kafkastrimziiov1beta2.KafkaUser](indexer, kafkastrimziiov1beta2.Resource("kafkauser"))}
+}
+
+// KafkaUsers returns an object that can list and get KafkaUsers.
+func (s *kafkaUserLister) KafkaUsers(namespace string) KafkaUserNamespaceLister {
+	return kafkaUserNamespaceLister{listers.NewNamespaced[*kafkastrimziiov1beta2.KafkaUser](s.ResourceIndexer, namespace)}
+}
+
+// KafkaUserNamespaceLister helps list and get KafkaUsers.
+// All objects returned here must be treated as read-only.
+type KafkaUserNamespaceLister interface {
+	// List lists all KafkaUsers in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*kafkastrimziiov1beta2.KafkaUser, err error)
+	// Get retrieves the KafkaUser from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*kafkastrimziiov1beta2.KafkaUser, error)
+	KafkaUserNamespaceListerExpansion
+}
+
+// kafkaUserNamespaceLister implements the KafkaUserNamespaceLister
+// interface.
+type kafkaUserNamespaceLister struct {
+	listers.ResourceIndexer[*kafkastrimziiov1beta2.KafkaUser]
+}
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+type ClusterResolver struct {
+	ResolveClusterStub        func(context.Context, v1beta2.KafkaTopic) (string, error)
+	resolveClusterMutex       sync.RWMutex
+	resolveClusterArgsForCall []struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+	}
+	resolveClusterReturns struct {
+		result1 string
+		result2 error
+	}
+	resolveClusterReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *ClusterResolver) ResolveCluster(arg1 context.Context, arg2 v1beta2.KafkaTopic) (string, error) {
+	fake.resolveClusterMutex.Lock()
+	ret, specificReturn := fake.resolveClusterReturnsOnCall[len(fake.resolveClusterArgsForCall)]
+	fake.resolveClusterArgsForCall = append(fake.resolveClusterArgsForCall, struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+	}{arg1, arg2})
+	stub := fake.ResolveClusterStub
+	fakeReturns := fake.resolveClusterReturns
+	fake.recordInvocation("ResolveCluster", []interface{}{arg1, arg2})
+	fake.resolveClusterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ClusterResolver) ResolveClusterCallCount() int {
+	fake.resolveClusterMutex.RLock()
+	defer fake.resolveClusterMutex.RUnlock()
+	return len(fake.resolveClusterArgsForCall)
+}
+
+func (fake *ClusterResolver) ResolveClusterCalls(stub func(context.Context, v1beta2.KafkaTopic) (string, error)) {
+	fake.resolveClusterMutex.Lock()
+	defer fake.resolveClusterMutex.Unlock()
+	fake.ResolveClusterStub = stub
+}
+
+func (fake *ClusterResolver) ResolveClusterArgsForCall(i int) (context.Context, v1beta2.KafkaTopic) {
+	fake.resolveClusterMutex.RLock()
+	defer fake.resolveClusterMutex.RUnlock()
+	argsForCall := fake.resolveClusterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ClusterResolver) ResolveClusterReturns(result1 string, result2 error) {
+	fake.resolveClusterMutex.Lock()
+	defer fake.resolveClusterMutex.Unlock()
+	fake.ResolveClusterStub = nil
+	fake.resolveClusterReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ClusterResolver) ResolveClusterReturnsOnCall(i int, result1 string, result2 error) {
+	fake.resolveClusterMutex.Lock()
+	defer fake.resolveClusterMutex.Unlock()
+	fake.ResolveClusterStub = nil
+	if fake.resolveClusterReturnsOnCall == nil {
+		fake.resolveClusterReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.resolveClusterReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ClusterResolver) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ClusterResolver) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ strimzi.ClusterResolver = new(ClusterResolver)
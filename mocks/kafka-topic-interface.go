@@ -29,6 +29,21 @@ type KafkaTopicInterface struct {
 		result1 *v1beta2.KafkaTopic
 		result2 error
 	}
+	ApplyStatusStub        func(context.Context, *v1beta2a.KafkaTopicApplyConfiguration, v1.ApplyOptions) (*v1beta2.KafkaTopic, error)
+	applyStatusMutex       sync.RWMutex
+	applyStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1beta2a.KafkaTopicApplyConfiguration
+		arg3 v1.ApplyOptions
+	}
+	applyStatusReturns struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
+	applyStatusReturnsOnCall map[int]struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
 	CreateStub        func(context.Context, *v1beta2.KafkaTopic, v1.CreateOptions) (*v1beta2.KafkaTopic, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -132,6 +147,21 @@ type KafkaTopicInterface struct {
 		result1 *v1beta2.KafkaTopic
 		result2 error
 	}
+	UpdateStatusStub        func(context.Context, *v1beta2.KafkaTopic, v1.UpdateOptions) (*v1beta2.KafkaTopic, error)
+	updateStatusMutex       sync.RWMutex
+	updateStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1beta2.KafkaTopic
+		arg3 v1.UpdateOptions
+	}
+	updateStatusReturns struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
+	updateStatusReturnsOnCall map[int]struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
 	WatchStub        func(context.Context, v1.ListOptions) (watch.Interface, error)
 	watchMutex       sync.RWMutex
 	watchArgsForCall []struct {
@@ -216,6 +246,72 @@ func (fake *KafkaTopicInterface) ApplyReturnsOnCall(i int, result1 *v1beta2.Kafk
 	}{result1, result2}
 }
 
+func (fake *KafkaTopicInterface) ApplyStatus(arg1 context.Context, arg2 *v1beta2a.KafkaTopicApplyConfiguration, arg3 v1.ApplyOptions) (*v1beta2.KafkaTopic, error) {
+	fake.applyStatusMutex.Lock()
+	ret, specificReturn := fake.applyStatusReturnsOnCall[len(fake.applyStatusArgsForCall)]
+	fake.applyStatusArgsForCall = append(fake.applyStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1beta2a.KafkaTopicApplyConfiguration
+		arg3 v1.ApplyOptions
+	}{arg1, arg2, arg3})
+	stub := fake.ApplyStatusStub
+	fakeReturns := fake.applyStatusReturns
+	fake.recordInvocation("ApplyStatus", []interface{}{arg1, arg2, arg3})
+	fake.applyStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *KafkaTopicInterface) ApplyStatusCallCount() int {
+	fake.applyStatusMutex.RLock()
+	defer fake.applyStatusMutex.RUnlock()
+	return len(fake.applyStatusArgsForCall)
+}
+
+func (fake *KafkaTopicInterface) ApplyStatusCalls(stub func(context.Context, *v1beta2a.KafkaTopicApplyConfiguration, v1.ApplyOptions) (*v1beta2.KafkaTopic, error)) {
+	fake.applyStatusMutex.Lock()
+	defer fake.applyStatusMutex.Unlock()
+	fake.ApplyStatusStub = stub
+}
+
+func (fake *KafkaTopicInterface) ApplyStatusArgsForCall(i int) (context.Context, *v1beta2a.KafkaTopicApplyConfiguration, v1.ApplyOptions) {
+	fake.applyStatusMutex.RLock()
+	defer fake.applyStatusMutex.RUnlock()
+	argsForCall := fake.applyStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *KafkaTopicInterface) ApplyStatusReturns(result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.applyStatusMutex.Lock()
+	defer fake.applyStatusMutex.Unlock()
+	fake.ApplyStatusStub = nil
+	fake.applyStatusReturns = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *KafkaTopicInterface) ApplyStatusReturnsOnCall(i int, result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.applyStatusMutex.Lock()
+	defer fake.applyStatusMutex.Unlock()
+	fake.ApplyStatusStub = nil
+	if fake.applyStatusReturnsOnCall == nil {
+		fake.applyStatusReturnsOnCall = make(map[int]struct {
+			result1 *v1beta2.KafkaTopic
+			result2 error
+		})
+	}
+	fake.applyStatusReturnsOnCall[i] = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *KafkaTopicInterface) Create(arg1 context.Context, arg2 *v1beta2.KafkaTopic, arg3 v1.CreateOptions) (*v1beta2.KafkaTopic, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -679,6 +775,72 @@ func (fake *KafkaTopicInterface) UpdateReturnsOnCall(i int, result1 *v1beta2.Kaf
 	}{result1, result2}
 }
 
+func (fake *KafkaTopicInterface) UpdateStatus(arg1 context.Context, arg2 *v1beta2.KafkaTopic, arg3 v1.UpdateOptions) (*v1beta2.KafkaTopic, error) {
+	fake.updateStatusMutex.Lock()
+	ret, specificReturn := fake.updateStatusReturnsOnCall[len(fake.updateStatusArgsForCall)]
+	fake.updateStatusArgsForCall = append(fake.updateStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1beta2.KafkaTopic
+		arg3 v1.UpdateOptions
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStatusStub
+	fakeReturns := fake.updateStatusReturns
+	fake.recordInvocation("UpdateStatus", []interface{}{arg1, arg2, arg3})
+	fake.updateStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *KafkaTopicInterface) UpdateStatusCallCount() int {
+	fake.updateStatusMutex.RLock()
+	defer fake.updateStatusMutex.RUnlock()
+	return len(fake.updateStatusArgsForCall)
+}
+
+func (fake *KafkaTopicInterface) UpdateStatusCalls(stub func(context.Context, *v1beta2.KafkaTopic, v1.UpdateOptions) (*v1beta2.KafkaTopic, error)) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = stub
+}
+
+func (fake *KafkaTopicInterface) UpdateStatusArgsForCall(i int) (context.Context, *v1beta2.KafkaTopic, v1.UpdateOptions) {
+	fake.updateStatusMutex.RLock()
+	defer fake.updateStatusMutex.RUnlock()
+	argsForCall := fake.updateStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *KafkaTopicInterface) UpdateStatusReturns(result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = nil
+	fake.updateStatusReturns = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *KafkaTopicInterface) UpdateStatusReturnsOnCall(i int, result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.updateStatusMutex.Lock()
+	defer fake.updateStatusMutex.Unlock()
+	fake.UpdateStatusStub = nil
+	if fake.updateStatusReturnsOnCall == nil {
+		fake.updateStatusReturnsOnCall = make(map[int]struct {
+			result1 *v1beta2.KafkaTopic
+			result2 error
+		})
+	}
+	fake.updateStatusReturnsOnCall[i] = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *KafkaTopicInterface) Watch(arg1 context.Context, arg2 v1.ListOptions) (watch.Interface, error) {
 	fake.watchMutex.Lock()
 	ret, specificReturn := fake.watchReturnsOnCall[len(fake.watchArgsForCall)]
@@ -10,6 +10,72 @@ import (
 )
 
 type KafkaV1beta2Interface struct {
+	KafkaBridgesStub        func(string) v1beta2.KafkaBridgeInterface
+	kafkaBridgesMutex       sync.RWMutex
+	kafkaBridgesArgsForCall []struct {
+		arg1 string
+	}
+	kafkaBridgesReturns struct {
+		result1 v1beta2.KafkaBridgeInterface
+	}
+	kafkaBridgesReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaBridgeInterface
+	}
+	KafkaConnectorsStub        func(string) v1beta2.KafkaConnectorInterface
+	kafkaConnectorsMutex       sync.RWMutex
+	kafkaConnectorsArgsForCall []struct {
+		arg1 string
+	}
+	kafkaConnectorsReturns struct {
+		result1 v1beta2.KafkaConnectorInterface
+	}
+	kafkaConnectorsReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaConnectorInterface
+	}
+	KafkaConnectsStub        func(string) v1beta2.KafkaConnectInterface
+	kafkaConnectsMutex       sync.RWMutex
+	kafkaConnectsArgsForCall []struct {
+		arg1 string
+	}
+	kafkaConnectsReturns struct {
+		result1 v1beta2.KafkaConnectInterface
+	}
+	kafkaConnectsReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaConnectInterface
+	}
+	KafkaMirrorMaker2sStub        func(string) v1beta2.KafkaMirrorMaker2Interface
+	kafkaMirrorMaker2sMutex       sync.RWMutex
+	kafkaMirrorMaker2sArgsForCall []struct {
+		arg1 string
+	}
+	kafkaMirrorMaker2sReturns struct {
+		result1 v1beta2.KafkaMirrorMaker2Interface
+	}
+	kafkaMirrorMaker2sReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaMirrorMaker2Interface
+	}
+	KafkaNodePoolsStub        func(string) v1beta2.KafkaNodePoolInterface
+	kafkaNodePoolsMutex       sync.RWMutex
+	kafkaNodePoolsArgsForCall []struct {
+		arg1 string
+	}
+	kafkaNodePoolsReturns struct {
+		result1 v1beta2.KafkaNodePoolInterface
+	}
+	kafkaNodePoolsReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaNodePoolInterface
+	}
+	KafkaRebalancesStub        func(string) v1beta2.KafkaRebalanceInterface
+	kafkaRebalancesMutex       sync.RWMutex
+	kafkaRebalancesArgsForCall []struct {
+		arg1 string
+	}
+	kafkaRebalancesReturns struct {
+		result1 v1beta2.KafkaRebalanceInterface
+	}
+	kafkaRebalancesReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaRebalanceInterface
+	}
 	KafkaTopicsStub        func(string) v1beta2.KafkaTopicInterface
 	kafkaTopicsMutex       sync.RWMutex
 	kafkaTopicsArgsForCall []struct {
@@ -21,6 +87,28 @@ type KafkaV1beta2Interface struct {
 	kafkaTopicsReturnsOnCall map[int]struct {
 		result1 v1beta2.KafkaTopicInterface
 	}
+	KafkaUsersStub        func(string) v1beta2.KafkaUserInterface
+	kafkaUsersMutex       sync.RWMutex
+	kafkaUsersArgsForCall []struct {
+		arg1 string
+	}
+	kafkaUsersReturns struct {
+		result1 v1beta2.KafkaUserInterface
+	}
+	kafkaUsersReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaUserInterface
+	}
+	KafkasStub        func(string) v1beta2.KafkaInterface
+	kafkasMutex       sync.RWMutex
+	kafkasArgsForCall []struct {
+		arg1 string
+	}
+	kafkasReturns struct {
+		result1 v1beta2.KafkaInterface
+	}
+	kafkasReturnsOnCall map[int]struct {
+		result1 v1beta2.KafkaInterface
+	}
 	RESTClientStub        func() rest.Interface
 	rESTClientMutex       sync.RWMutex
 	rESTClientArgsForCall []struct {
@@ -35,6 +123,372 @@ type KafkaV1beta2Interface struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *KafkaV1beta2Interface) KafkaBridges(arg1 string) v1beta2.KafkaBridgeInterface {
+	fake.kafkaBridgesMutex.Lock()
+	ret, specificReturn := fake.kafkaBridgesReturnsOnCall[len(fake.kafkaBridgesArgsForCall)]
+	fake.kafkaBridgesArgsForCall = append(fake.kafkaBridgesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaBridgesStub
+	fakeReturns := fake.kafkaBridgesReturns
+	fake.recordInvocation("KafkaBridges", []interface{}{arg1})
+	fake.kafkaBridgesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaBridgesCallCount() int {
+	fake.kafkaBridgesMutex.RLock()
+	defer fake.kafkaBridgesMutex.RUnlock()
+	return len(fake.kafkaBridgesArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaBridgesCalls(stub func(string) v1beta2.KafkaBridgeInterface) {
+	fake.kafkaBridgesMutex.Lock()
+	defer fake.kafkaBridgesMutex.Unlock()
+	fake.KafkaBridgesStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaBridgesArgsForCall(i int) string {
+	fake.kafkaBridgesMutex.RLock()
+	defer fake.kafkaBridgesMutex.RUnlock()
+	argsForCall := fake.kafkaBridgesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaBridgesReturns(result1 v1beta2.KafkaBridgeInterface) {
+	fake.kafkaBridgesMutex.Lock()
+	defer fake.kafkaBridgesMutex.Unlock()
+	fake.KafkaBridgesStub = nil
+	fake.kafkaBridgesReturns = struct {
+		result1 v1beta2.KafkaBridgeInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaBridgesReturnsOnCall(i int, result1 v1beta2.KafkaBridgeInterface) {
+	fake.kafkaBridgesMutex.Lock()
+	defer fake.kafkaBridgesMutex.Unlock()
+	fake.KafkaBridgesStub = nil
+	if fake.kafkaBridgesReturnsOnCall == nil {
+		fake.kafkaBridgesReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaBridgeInterface
+		})
+	}
+	fake.kafkaBridgesReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaBridgeInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectors(arg1 string) v1beta2.KafkaConnectorInterface {
+	fake.kafkaConnectorsMutex.Lock()
+	ret, specificReturn := fake.kafkaConnectorsReturnsOnCall[len(fake.kafkaConnectorsArgsForCall)]
+	fake.kafkaConnectorsArgsForCall = append(fake.kafkaConnectorsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaConnectorsStub
+	fakeReturns := fake.kafkaConnectorsReturns
+	fake.recordInvocation("KafkaConnectors", []interface{}{arg1})
+	fake.kafkaConnectorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectorsCallCount() int {
+	fake.kafkaConnectorsMutex.RLock()
+	defer fake.kafkaConnectorsMutex.RUnlock()
+	return len(fake.kafkaConnectorsArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectorsCalls(stub func(string) v1beta2.KafkaConnectorInterface) {
+	fake.kafkaConnectorsMutex.Lock()
+	defer fake.kafkaConnectorsMutex.Unlock()
+	fake.KafkaConnectorsStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectorsArgsForCall(i int) string {
+	fake.kafkaConnectorsMutex.RLock()
+	defer fake.kafkaConnectorsMutex.RUnlock()
+	argsForCall := fake.kafkaConnectorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectorsReturns(result1 v1beta2.KafkaConnectorInterface) {
+	fake.kafkaConnectorsMutex.Lock()
+	defer fake.kafkaConnectorsMutex.Unlock()
+	fake.KafkaConnectorsStub = nil
+	fake.kafkaConnectorsReturns = struct {
+		result1 v1beta2.KafkaConnectorInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectorsReturnsOnCall(i int, result1 v1beta2.KafkaConnectorInterface) {
+	fake.kafkaConnectorsMutex.Lock()
+	defer fake.kafkaConnectorsMutex.Unlock()
+	fake.KafkaConnectorsStub = nil
+	if fake.kafkaConnectorsReturnsOnCall == nil {
+		fake.kafkaConnectorsReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaConnectorInterface
+		})
+	}
+	fake.kafkaConnectorsReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaConnectorInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnects(arg1 string) v1beta2.KafkaConnectInterface {
+	fake.kafkaConnectsMutex.Lock()
+	ret, specificReturn := fake.kafkaConnectsReturnsOnCall[len(fake.kafkaConnectsArgsForCall)]
+	fake.kafkaConnectsArgsForCall = append(fake.kafkaConnectsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaConnectsStub
+	fakeReturns := fake.kafkaConnectsReturns
+	fake.recordInvocation("KafkaConnects", []interface{}{arg1})
+	fake.kafkaConnectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectsCallCount() int {
+	fake.kafkaConnectsMutex.RLock()
+	defer fake.kafkaConnectsMutex.RUnlock()
+	return len(fake.kafkaConnectsArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectsCalls(stub func(string) v1beta2.KafkaConnectInterface) {
+	fake.kafkaConnectsMutex.Lock()
+	defer fake.kafkaConnectsMutex.Unlock()
+	fake.KafkaConnectsStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectsArgsForCall(i int) string {
+	fake.kafkaConnectsMutex.RLock()
+	defer fake.kafkaConnectsMutex.RUnlock()
+	argsForCall := fake.kafkaConnectsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectsReturns(result1 v1beta2.KafkaConnectInterface) {
+	fake.kafkaConnectsMutex.Lock()
+	defer fake.kafkaConnectsMutex.Unlock()
+	fake.KafkaConnectsStub = nil
+	fake.kafkaConnectsReturns = struct {
+		result1 v1beta2.KafkaConnectInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaConnectsReturnsOnCall(i int, result1 v1beta2.KafkaConnectInterface) {
+	fake.kafkaConnectsMutex.Lock()
+	defer fake.kafkaConnectsMutex.Unlock()
+	fake.KafkaConnectsStub = nil
+	if fake.kafkaConnectsReturnsOnCall == nil {
+		fake.kafkaConnectsReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaConnectInterface
+		})
+	}
+	fake.kafkaConnectsReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaConnectInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2s(arg1 string) v1beta2.KafkaMirrorMaker2Interface {
+	fake.kafkaMirrorMaker2sMutex.Lock()
+	ret, specificReturn := fake.kafkaMirrorMaker2sReturnsOnCall[len(fake.kafkaMirrorMaker2sArgsForCall)]
+	fake.kafkaMirrorMaker2sArgsForCall = append(fake.kafkaMirrorMaker2sArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaMirrorMaker2sStub
+	fakeReturns := fake.kafkaMirrorMaker2sReturns
+	fake.recordInvocation("KafkaMirrorMaker2s", []interface{}{arg1})
+	fake.kafkaMirrorMaker2sMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2sCallCount() int {
+	fake.kafkaMirrorMaker2sMutex.RLock()
+	defer fake.kafkaMirrorMaker2sMutex.RUnlock()
+	return len(fake.kafkaMirrorMaker2sArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2sCalls(stub func(string) v1beta2.KafkaMirrorMaker2Interface) {
+	fake.kafkaMirrorMaker2sMutex.Lock()
+	defer fake.kafkaMirrorMaker2sMutex.Unlock()
+	fake.KafkaMirrorMaker2sStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2sArgsForCall(i int) string {
+	fake.kafkaMirrorMaker2sMutex.RLock()
+	defer fake.kafkaMirrorMaker2sMutex.RUnlock()
+	argsForCall := fake.kafkaMirrorMaker2sArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2sReturns(result1 v1beta2.KafkaMirrorMaker2Interface) {
+	fake.kafkaMirrorMaker2sMutex.Lock()
+	defer fake.kafkaMirrorMaker2sMutex.Unlock()
+	fake.KafkaMirrorMaker2sStub = nil
+	fake.kafkaMirrorMaker2sReturns = struct {
+		result1 v1beta2.KafkaMirrorMaker2Interface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaMirrorMaker2sReturnsOnCall(i int, result1 v1beta2.KafkaMirrorMaker2Interface) {
+	fake.kafkaMirrorMaker2sMutex.Lock()
+	defer fake.kafkaMirrorMaker2sMutex.Unlock()
+	fake.KafkaMirrorMaker2sStub = nil
+	if fake.kafkaMirrorMaker2sReturnsOnCall == nil {
+		fake.kafkaMirrorMaker2sReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaMirrorMaker2Interface
+		})
+	}
+	fake.kafkaMirrorMaker2sReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaMirrorMaker2Interface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePools(arg1 string) v1beta2.KafkaNodePoolInterface {
+	fake.kafkaNodePoolsMutex.Lock()
+	ret, specificReturn := fake.kafkaNodePoolsReturnsOnCall[len(fake.kafkaNodePoolsArgsForCall)]
+	fake.kafkaNodePoolsArgsForCall = append(fake.kafkaNodePoolsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaNodePoolsStub
+	fakeReturns := fake.kafkaNodePoolsReturns
+	fake.recordInvocation("KafkaNodePools", []interface{}{arg1})
+	fake.kafkaNodePoolsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePoolsCallCount() int {
+	fake.kafkaNodePoolsMutex.RLock()
+	defer fake.kafkaNodePoolsMutex.RUnlock()
+	return len(fake.kafkaNodePoolsArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePoolsCalls(stub func(string) v1beta2.KafkaNodePoolInterface) {
+	fake.kafkaNodePoolsMutex.Lock()
+	defer fake.kafkaNodePoolsMutex.Unlock()
+	fake.KafkaNodePoolsStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePoolsArgsForCall(i int) string {
+	fake.kafkaNodePoolsMutex.RLock()
+	defer fake.kafkaNodePoolsMutex.RUnlock()
+	argsForCall := fake.kafkaNodePoolsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePoolsReturns(result1 v1beta2.KafkaNodePoolInterface) {
+	fake.kafkaNodePoolsMutex.Lock()
+	defer fake.kafkaNodePoolsMutex.Unlock()
+	fake.KafkaNodePoolsStub = nil
+	fake.kafkaNodePoolsReturns = struct {
+		result1 v1beta2.KafkaNodePoolInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaNodePoolsReturnsOnCall(i int, result1 v1beta2.KafkaNodePoolInterface) {
+	fake.kafkaNodePoolsMutex.Lock()
+	defer fake.kafkaNodePoolsMutex.Unlock()
+	fake.KafkaNodePoolsStub = nil
+	if fake.kafkaNodePoolsReturnsOnCall == nil {
+		fake.kafkaNodePoolsReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaNodePoolInterface
+		})
+	}
+	fake.kafkaNodePoolsReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaNodePoolInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalances(arg1 string) v1beta2.KafkaRebalanceInterface {
+	fake.kafkaRebalancesMutex.Lock()
+	ret, specificReturn := fake.kafkaRebalancesReturnsOnCall[len(fake.kafkaRebalancesArgsForCall)]
+	fake.kafkaRebalancesArgsForCall = append(fake.kafkaRebalancesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaRebalancesStub
+	fakeReturns := fake.kafkaRebalancesReturns
+	fake.recordInvocation("KafkaRebalances", []interface{}{arg1})
+	fake.kafkaRebalancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalancesCallCount() int {
+	fake.kafkaRebalancesMutex.RLock()
+	defer fake.kafkaRebalancesMutex.RUnlock()
+	return len(fake.kafkaRebalancesArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalancesCalls(stub func(string) v1beta2.KafkaRebalanceInterface) {
+	fake.kafkaRebalancesMutex.Lock()
+	defer fake.kafkaRebalancesMutex.Unlock()
+	fake.KafkaRebalancesStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalancesArgsForCall(i int) string {
+	fake.kafkaRebalancesMutex.RLock()
+	defer fake.kafkaRebalancesMutex.RUnlock()
+	argsForCall := fake.kafkaRebalancesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalancesReturns(result1 v1beta2.KafkaRebalanceInterface) {
+	fake.kafkaRebalancesMutex.Lock()
+	defer fake.kafkaRebalancesMutex.Unlock()
+	fake.KafkaRebalancesStub = nil
+	fake.kafkaRebalancesReturns = struct {
+		result1 v1beta2.KafkaRebalanceInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaRebalancesReturnsOnCall(i int, result1 v1beta2.KafkaRebalanceInterface) {
+	fake.kafkaRebalancesMutex.Lock()
+	defer fake.kafkaRebalancesMutex.Unlock()
+	fake.KafkaRebalancesStub = nil
+	if fake.kafkaRebalancesReturnsOnCall == nil {
+		fake.kafkaRebalancesReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaRebalanceInterface
+		})
+	}
+	fake.kafkaRebalancesReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaRebalanceInterface
+	}{result1}
+}
+
 func (fake *KafkaV1beta2Interface) KafkaTopics(arg1 string) v1beta2.KafkaTopicInterface {
 	fake.kafkaTopicsMutex.Lock()
 	ret, specificReturn := fake.kafkaTopicsReturnsOnCall[len(fake.kafkaTopicsArgsForCall)]
@@ -96,6 +550,128 @@ func (fake *KafkaV1beta2Interface) KafkaTopicsReturnsOnCall(i int, result1 v1bet
 	}{result1}
 }
 
+func (fake *KafkaV1beta2Interface) KafkaUsers(arg1 string) v1beta2.KafkaUserInterface {
+	fake.kafkaUsersMutex.Lock()
+	ret, specificReturn := fake.kafkaUsersReturnsOnCall[len(fake.kafkaUsersArgsForCall)]
+	fake.kafkaUsersArgsForCall = append(fake.kafkaUsersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaUsersStub
+	fakeReturns := fake.kafkaUsersReturns
+	fake.recordInvocation("KafkaUsers", []interface{}{arg1})
+	fake.kafkaUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaUsersCallCount() int {
+	fake.kafkaUsersMutex.RLock()
+	defer fake.kafkaUsersMutex.RUnlock()
+	return len(fake.kafkaUsersArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkaUsersCalls(stub func(string) v1beta2.KafkaUserInterface) {
+	fake.kafkaUsersMutex.Lock()
+	defer fake.kafkaUsersMutex.Unlock()
+	fake.KafkaUsersStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkaUsersArgsForCall(i int) string {
+	fake.kafkaUsersMutex.RLock()
+	defer fake.kafkaUsersMutex.RUnlock()
+	argsForCall := fake.kafkaUsersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkaUsersReturns(result1 v1beta2.KafkaUserInterface) {
+	fake.kafkaUsersMutex.Lock()
+	defer fake.kafkaUsersMutex.Unlock()
+	fake.KafkaUsersStub = nil
+	fake.kafkaUsersReturns = struct {
+		result1 v1beta2.KafkaUserInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkaUsersReturnsOnCall(i int, result1 v1beta2.KafkaUserInterface) {
+	fake.kafkaUsersMutex.Lock()
+	defer fake.kafkaUsersMutex.Unlock()
+	fake.KafkaUsersStub = nil
+	if fake.kafkaUsersReturnsOnCall == nil {
+		fake.kafkaUsersReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaUserInterface
+		})
+	}
+	fake.kafkaUsersReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaUserInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) Kafkas(arg1 string) v1beta2.KafkaInterface {
+	fake.kafkasMutex.Lock()
+	ret, specificReturn := fake.kafkasReturnsOnCall[len(fake.kafkasArgsForCall)]
+	fake.kafkasArgsForCall = append(fake.kafkasArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkasStub
+	fakeReturns := fake.kafkasReturns
+	fake.recordInvocation("Kafkas", []interface{}{arg1})
+	fake.kafkasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1beta2Interface) KafkasCallCount() int {
+	fake.kafkasMutex.RLock()
+	defer fake.kafkasMutex.RUnlock()
+	return len(fake.kafkasArgsForCall)
+}
+
+func (fake *KafkaV1beta2Interface) KafkasCalls(stub func(string) v1beta2.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = stub
+}
+
+func (fake *KafkaV1beta2Interface) KafkasArgsForCall(i int) string {
+	fake.kafkasMutex.RLock()
+	defer fake.kafkasMutex.RUnlock()
+	argsForCall := fake.kafkasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1beta2Interface) KafkasReturns(result1 v1beta2.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = nil
+	fake.kafkasReturns = struct {
+		result1 v1beta2.KafkaInterface
+	}{result1}
+}
+
+func (fake *KafkaV1beta2Interface) KafkasReturnsOnCall(i int, result1 v1beta2.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = nil
+	if fake.kafkasReturnsOnCall == nil {
+		fake.kafkasReturnsOnCall = make(map[int]struct {
+			result1 v1beta2.KafkaInterface
+		})
+	}
+	fake.kafkasReturnsOnCall[i] = struct {
+		result1 v1beta2.KafkaInterface
+	}{result1}
+}
+
 func (fake *KafkaV1beta2Interface) RESTClient() rest.Interface {
 	fake.rESTClientMutex.Lock()
 	ret, specificReturn := fake.rESTClientReturnsOnCall[len(fake.rESTClientArgsForCall)]
@@ -0,0 +1,246 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"sync"
+
+	"github.com/bborbe/strimzi"
+	v1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	"k8s.io/client-go/rest"
+)
+
+type KafkaV1Interface struct {
+	KafkaTopicsStub        func(string) v1.KafkaTopicInterface
+	kafkaTopicsMutex       sync.RWMutex
+	kafkaTopicsArgsForCall []struct {
+		arg1 string
+	}
+	kafkaTopicsReturns struct {
+		result1 v1.KafkaTopicInterface
+	}
+	kafkaTopicsReturnsOnCall map[int]struct {
+		result1 v1.KafkaTopicInterface
+	}
+	KafkasStub        func(string) v1.KafkaInterface
+	kafkasMutex       sync.RWMutex
+	kafkasArgsForCall []struct {
+		arg1 string
+	}
+	kafkasReturns struct {
+		result1 v1.KafkaInterface
+	}
+	kafkasReturnsOnCall map[int]struct {
+		result1 v1.KafkaInterface
+	}
+	RESTClientStub        func() rest.Interface
+	rESTClientMutex       sync.RWMutex
+	rESTClientArgsForCall []struct {
+	}
+	rESTClientReturns struct {
+		result1 rest.Interface
+	}
+	rESTClientReturnsOnCall map[int]struct {
+		result1 rest.Interface
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *KafkaV1Interface) KafkaTopics(arg1 string) v1.KafkaTopicInterface {
+	fake.kafkaTopicsMutex.Lock()
+	ret, specificReturn := fake.kafkaTopicsReturnsOnCall[len(fake.kafkaTopicsArgsForCall)]
+	fake.kafkaTopicsArgsForCall = append(fake.kafkaTopicsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkaTopicsStub
+	fakeReturns := fake.kafkaTopicsReturns
+	fake.recordInvocation("KafkaTopics", []interface{}{arg1})
+	fake.kafkaTopicsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1Interface) KafkaTopicsCallCount() int {
+	fake.kafkaTopicsMutex.RLock()
+	defer fake.kafkaTopicsMutex.RUnlock()
+	return len(fake.kafkaTopicsArgsForCall)
+}
+
+func (fake *KafkaV1Interface) KafkaTopicsCalls(stub func(string) v1.KafkaTopicInterface) {
+	fake.kafkaTopicsMutex.Lock()
+	defer fake.kafkaTopicsMutex.Unlock()
+	fake.KafkaTopicsStub = stub
+}
+
+func (fake *KafkaV1Interface) KafkaTopicsArgsForCall(i int) string {
+	fake.kafkaTopicsMutex.RLock()
+	defer fake.kafkaTopicsMutex.RUnlock()
+	argsForCall := fake.kafkaTopicsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1Interface) KafkaTopicsReturns(result1 v1.KafkaTopicInterface) {
+	fake.kafkaTopicsMutex.Lock()
+	defer fake.kafkaTopicsMutex.Unlock()
+	fake.KafkaTopicsStub = nil
+	fake.kafkaTopicsReturns = struct {
+		result1 v1.KafkaTopicInterface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) KafkaTopicsReturnsOnCall(i int, result1 v1.KafkaTopicInterface) {
+	fake.kafkaTopicsMutex.Lock()
+	defer fake.kafkaTopicsMutex.Unlock()
+	fake.KafkaTopicsStub = nil
+	if fake.kafkaTopicsReturnsOnCall == nil {
+		fake.kafkaTopicsReturnsOnCall = make(map[int]struct {
+			result1 v1.KafkaTopicInterface
+		})
+	}
+	fake.kafkaTopicsReturnsOnCall[i] = struct {
+		result1 v1.KafkaTopicInterface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) Kafkas(arg1 string) v1.KafkaInterface {
+	fake.kafkasMutex.Lock()
+	ret, specificReturn := fake.kafkasReturnsOnCall[len(fake.kafkasArgsForCall)]
+	fake.kafkasArgsForCall = append(fake.kafkasArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.KafkasStub
+	fakeReturns := fake.kafkasReturns
+	fake.recordInvocation("Kafkas", []interface{}{arg1})
+	fake.kafkasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1Interface) KafkasCallCount() int {
+	fake.kafkasMutex.RLock()
+	defer fake.kafkasMutex.RUnlock()
+	return len(fake.kafkasArgsForCall)
+}
+
+func (fake *KafkaV1Interface) KafkasCalls(stub func(string) v1.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = stub
+}
+
+func (fake *KafkaV1Interface) KafkasArgsForCall(i int) string {
+	fake.kafkasMutex.RLock()
+	defer fake.kafkasMutex.RUnlock()
+	argsForCall := fake.kafkasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *KafkaV1Interface) KafkasReturns(result1 v1.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = nil
+	fake.kafkasReturns = struct {
+		result1 v1.KafkaInterface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) KafkasReturnsOnCall(i int, result1 v1.KafkaInterface) {
+	fake.kafkasMutex.Lock()
+	defer fake.kafkasMutex.Unlock()
+	fake.KafkasStub = nil
+	if fake.kafkasReturnsOnCall == nil {
+		fake.kafkasReturnsOnCall = make(map[int]struct {
+			result1 v1.KafkaInterface
+		})
+	}
+	fake.kafkasReturnsOnCall[i] = struct {
+		result1 v1.KafkaInterface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) RESTClient() rest.Interface {
+	fake.rESTClientMutex.Lock()
+	ret, specificReturn := fake.rESTClientReturnsOnCall[len(fake.rESTClientArgsForCall)]
+	fake.rESTClientArgsForCall = append(fake.rESTClientArgsForCall, struct {
+	}{})
+	stub := fake.RESTClientStub
+	fakeReturns := fake.rESTClientReturns
+	fake.recordInvocation("RESTClient", []interface{}{})
+	fake.rESTClientMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *KafkaV1Interface) RESTClientCallCount() int {
+	fake.rESTClientMutex.RLock()
+	defer fake.rESTClientMutex.RUnlock()
+	return len(fake.rESTClientArgsForCall)
+}
+
+func (fake *KafkaV1Interface) RESTClientCalls(stub func() rest.Interface) {
+	fake.rESTClientMutex.Lock()
+	defer fake.rESTClientMutex.Unlock()
+	fake.RESTClientStub = stub
+}
+
+func (fake *KafkaV1Interface) RESTClientReturns(result1 rest.Interface) {
+	fake.rESTClientMutex.Lock()
+	defer fake.rESTClientMutex.Unlock()
+	fake.RESTClientStub = nil
+	fake.rESTClientReturns = struct {
+		result1 rest.Interface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) RESTClientReturnsOnCall(i int, result1 rest.Interface) {
+	fake.rESTClientMutex.Lock()
+	defer fake.rESTClientMutex.Unlock()
+	fake.RESTClientStub = nil
+	if fake.rESTClientReturnsOnCall == nil {
+		fake.rESTClientReturnsOnCall = make(map[int]struct {
+			result1 rest.Interface
+		})
+	}
+	fake.rESTClientReturnsOnCall[i] = struct {
+		result1 rest.Interface
+	}{result1}
+}
+
+func (fake *KafkaV1Interface) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *KafkaV1Interface) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ strimzi.KafkaV1Interface = new(KafkaV1Interface)
@@ -0,0 +1,425 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+type RebalanceManager struct {
+	ApproveStub        func(context.Context, string, string) error
+	approveMutex       sync.RWMutex
+	approveArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	approveReturns struct {
+		result1 error
+	}
+	approveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefreshStub        func(context.Context, string, string) error
+	refreshMutex       sync.RWMutex
+	refreshArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	refreshReturns struct {
+		result1 error
+	}
+	refreshReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RequestStub        func(context.Context, v1beta2.KafkaRebalance) error
+	requestMutex       sync.RWMutex
+	requestArgsForCall []struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaRebalance
+	}
+	requestReturns struct {
+		result1 error
+	}
+	requestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StopStub        func(context.Context, string, string) error
+	stopMutex       sync.RWMutex
+	stopArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	stopReturns struct {
+		result1 error
+	}
+	stopReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForProposalStub        func(context.Context, string, string, time.Duration) (*v1beta2.KafkaRebalance, error)
+	waitForProposalMutex       sync.RWMutex
+	waitForProposalArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}
+	waitForProposalReturns struct {
+		result1 *v1beta2.KafkaRebalance
+		result2 error
+	}
+	waitForProposalReturnsOnCall map[int]struct {
+		result1 *v1beta2.KafkaRebalance
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *RebalanceManager) Approve(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.approveMutex.Lock()
+	ret, specificReturn := fake.approveReturnsOnCall[len(fake.approveArgsForCall)]
+	fake.approveArgsForCall = append(fake.approveArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ApproveStub
+	fakeReturns := fake.approveReturns
+	fake.recordInvocation("Approve", []interface{}{arg1, arg2, arg3})
+	fake.approveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RebalanceManager) ApproveCallCount() int {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	return len(fake.approveArgsForCall)
+}
+
+func (fake *RebalanceManager) ApproveCalls(stub func(context.Context, string, string) error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = stub
+}
+
+func (fake *RebalanceManager) ApproveArgsForCall(i int) (context.Context, string, string) {
+	fake.approveMutex.RLock()
+	defer fake.approveMutex.RUnlock()
+	argsForCall := fake.approveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RebalanceManager) ApproveReturns(result1 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	fake.approveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) ApproveReturnsOnCall(i int, result1 error) {
+	fake.approveMutex.Lock()
+	defer fake.approveMutex.Unlock()
+	fake.ApproveStub = nil
+	if fake.approveReturnsOnCall == nil {
+		fake.approveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) Refresh(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.refreshMutex.Lock()
+	ret, specificReturn := fake.refreshReturnsOnCall[len(fake.refreshArgsForCall)]
+	fake.refreshArgsForCall = append(fake.refreshArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RefreshStub
+	fakeReturns := fake.refreshReturns
+	fake.recordInvocation("Refresh", []interface{}{arg1, arg2, arg3})
+	fake.refreshMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RebalanceManager) RefreshCallCount() int {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	return len(fake.refreshArgsForCall)
+}
+
+func (fake *RebalanceManager) RefreshCalls(stub func(context.Context, string, string) error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = stub
+}
+
+func (fake *RebalanceManager) RefreshArgsForCall(i int) (context.Context, string, string) {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	argsForCall := fake.refreshArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RebalanceManager) RefreshReturns(result1 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	fake.refreshReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) RefreshReturnsOnCall(i int, result1 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	if fake.refreshReturnsOnCall == nil {
+		fake.refreshReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) Request(arg1 context.Context, arg2 v1beta2.KafkaRebalance) error {
+	fake.requestMutex.Lock()
+	ret, specificReturn := fake.requestReturnsOnCall[len(fake.requestArgsForCall)]
+	fake.requestArgsForCall = append(fake.requestArgsForCall, struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaRebalance
+	}{arg1, arg2})
+	stub := fake.RequestStub
+	fakeReturns := fake.requestReturns
+	fake.recordInvocation("Request", []interface{}{arg1, arg2})
+	fake.requestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RebalanceManager) RequestCallCount() int {
+	fake.requestMutex.RLock()
+	defer fake.requestMutex.RUnlock()
+	return len(fake.requestArgsForCall)
+}
+
+func (fake *RebalanceManager) RequestCalls(stub func(context.Context, v1beta2.KafkaRebalance) error) {
+	fake.requestMutex.Lock()
+	defer fake.requestMutex.Unlock()
+	fake.RequestStub = stub
+}
+
+func (fake *RebalanceManager) RequestArgsForCall(i int) (context.Context, v1beta2.KafkaRebalance) {
+	fake.requestMutex.RLock()
+	defer fake.requestMutex.RUnlock()
+	argsForCall := fake.requestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *RebalanceManager) RequestReturns(result1 error) {
+	fake.requestMutex.Lock()
+	defer fake.requestMutex.Unlock()
+	fake.RequestStub = nil
+	fake.requestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) RequestReturnsOnCall(i int, result1 error) {
+	fake.requestMutex.Lock()
+	defer fake.requestMutex.Unlock()
+	fake.RequestStub = nil
+	if fake.requestReturnsOnCall == nil {
+		fake.requestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) Stop(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.stopMutex.Lock()
+	ret, specificReturn := fake.stopReturnsOnCall[len(fake.stopArgsForCall)]
+	fake.stopArgsForCall = append(fake.stopArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.StopStub
+	fakeReturns := fake.stopReturns
+	fake.recordInvocation("Stop", []interface{}{arg1, arg2, arg3})
+	fake.stopMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *RebalanceManager) StopCallCount() int {
+	fake.stopMutex.RLock()
+	defer fake.stopMutex.RUnlock()
+	return len(fake.stopArgsForCall)
+}
+
+func (fake *RebalanceManager) StopCalls(stub func(context.Context, string, string) error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = stub
+}
+
+func (fake *RebalanceManager) StopArgsForCall(i int) (context.Context, string, string) {
+	fake.stopMutex.RLock()
+	defer fake.stopMutex.RUnlock()
+	argsForCall := fake.stopArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *RebalanceManager) StopReturns(result1 error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = nil
+	fake.stopReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) StopReturnsOnCall(i int, result1 error) {
+	fake.stopMutex.Lock()
+	defer fake.stopMutex.Unlock()
+	fake.StopStub = nil
+	if fake.stopReturnsOnCall == nil {
+		fake.stopReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stopReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RebalanceManager) WaitForProposal(arg1 context.Context, arg2 string, arg3 string, arg4 time.Duration) (*v1beta2.KafkaRebalance, error) {
+	fake.waitForProposalMutex.Lock()
+	ret, specificReturn := fake.waitForProposalReturnsOnCall[len(fake.waitForProposalArgsForCall)]
+	fake.waitForProposalArgsForCall = append(fake.waitForProposalArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.WaitForProposalStub
+	fakeReturns := fake.waitForProposalReturns
+	fake.recordInvocation("WaitForProposal", []interface{}{arg1, arg2, arg3, arg4})
+	fake.waitForProposalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *RebalanceManager) WaitForProposalCallCount() int {
+	fake.waitForProposalMutex.RLock()
+	defer fake.waitForProposalMutex.RUnlock()
+	return len(fake.waitForProposalArgsForCall)
+}
+
+func (fake *RebalanceManager) WaitForProposalCalls(stub func(context.Context, string, string, time.Duration) (*v1beta2.KafkaRebalance, error)) {
+	fake.waitForProposalMutex.Lock()
+	defer fake.waitForProposalMutex.Unlock()
+	fake.WaitForProposalStub = stub
+}
+
+func (fake *RebalanceManager) WaitForProposalArgsForCall(i int) (context.Context, string, string, time.Duration) {
+	fake.waitForProposalMutex.RLock()
+	defer fake.waitForProposalMutex.RUnlock()
+	argsForCall := fake.waitForProposalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *RebalanceManager) WaitForProposalReturns(result1 *v1beta2.KafkaRebalance, result2 error) {
+	fake.waitForProposalMutex.Lock()
+	defer fake.waitForProposalMutex.Unlock()
+	fake.WaitForProposalStub = nil
+	fake.waitForProposalReturns = struct {
+		result1 *v1beta2.KafkaRebalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RebalanceManager) WaitForProposalReturnsOnCall(i int, result1 *v1beta2.KafkaRebalance, result2 error) {
+	fake.waitForProposalMutex.Lock()
+	defer fake.waitForProposalMutex.Unlock()
+	fake.WaitForProposalStub = nil
+	if fake.waitForProposalReturnsOnCall == nil {
+		fake.waitForProposalReturnsOnCall = make(map[int]struct {
+			result1 *v1beta2.KafkaRebalance
+			result2 error
+		})
+	}
+	fake.waitForProposalReturnsOnCall[i] = struct {
+		result1 *v1beta2.KafkaRebalance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *RebalanceManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *RebalanceManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ strimzi.RebalanceManager = new(RebalanceManager)
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/bborbe/strimzi"
+	v1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
 	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
 	"k8s.io/client-go/discovery"
 )
@@ -20,6 +21,16 @@ type StrimziClientset struct {
 	discoveryReturnsOnCall map[int]struct {
 		result1 discovery.DiscoveryInterface
 	}
+	KafkaV1Stub        func() v1.KafkaV1Interface
+	kafkaV1Mutex       sync.RWMutex
+	kafkaV1ArgsForCall []struct {
+	}
+	kafkaV1Returns struct {
+		result1 v1.KafkaV1Interface
+	}
+	kafkaV1ReturnsOnCall map[int]struct {
+		result1 v1.KafkaV1Interface
+	}
 	KafkaV1beta2Stub        func() v1beta2.KafkaV1beta2Interface
 	kafkaV1beta2Mutex       sync.RWMutex
 	kafkaV1beta2ArgsForCall []struct {
@@ -87,6 +98,59 @@ func (fake *StrimziClientset) DiscoveryReturnsOnCall(i int, result1 discovery.Di
 	}{result1}
 }
 
+func (fake *StrimziClientset) KafkaV1() v1.KafkaV1Interface {
+	fake.kafkaV1Mutex.Lock()
+	ret, specificReturn := fake.kafkaV1ReturnsOnCall[len(fake.kafkaV1ArgsForCall)]
+	fake.kafkaV1ArgsForCall = append(fake.kafkaV1ArgsForCall, struct {
+	}{})
+	stub := fake.KafkaV1Stub
+	fakeReturns := fake.kafkaV1Returns
+	fake.recordInvocation("KafkaV1", []interface{}{})
+	fake.kafkaV1Mutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *StrimziClientset) KafkaV1CallCount() int {
+	fake.kafkaV1Mutex.RLock()
+	defer fake.kafkaV1Mutex.RUnlock()
+	return len(fake.kafkaV1ArgsForCall)
+}
+
+func (fake *StrimziClientset) KafkaV1Calls(stub func() v1.KafkaV1Interface) {
+	fake.kafkaV1Mutex.Lock()
+	defer fake.kafkaV1Mutex.Unlock()
+	fake.KafkaV1Stub = stub
+}
+
+func (fake *StrimziClientset) KafkaV1Returns(result1 v1.KafkaV1Interface) {
+	fake.kafkaV1Mutex.Lock()
+	defer fake.kafkaV1Mutex.Unlock()
+	fake.KafkaV1Stub = nil
+	fake.kafkaV1Returns = struct {
+		result1 v1.KafkaV1Interface
+	}{result1}
+}
+
+func (fake *StrimziClientset) KafkaV1ReturnsOnCall(i int, result1 v1.KafkaV1Interface) {
+	fake.kafkaV1Mutex.Lock()
+	defer fake.kafkaV1Mutex.Unlock()
+	fake.KafkaV1Stub = nil
+	if fake.kafkaV1ReturnsOnCall == nil {
+		fake.kafkaV1ReturnsOnCall = make(map[int]struct {
+			result1 v1.KafkaV1Interface
+		})
+	}
+	fake.kafkaV1ReturnsOnCall[i] = struct {
+		result1 v1.KafkaV1Interface
+	}{result1}
+}
+
 func (fake *StrimziClientset) KafkaV1beta2() v1beta2.KafkaV1beta2Interface {
 	fake.kafkaV1beta2Mutex.Lock()
 	ret, specificReturn := fake.kafkaV1beta2ReturnsOnCall[len(fake.kafkaV1beta2ArgsForCall)]
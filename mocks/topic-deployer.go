@@ -4,6 +4,7 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/bborbe/strimzi"
 	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
@@ -22,12 +23,42 @@ type TopicDeployer struct {
 	deployReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UndeployStub        func(context.Context, string, string) error
+	DeployAndWaitStub        func(context.Context, v1beta2.KafkaTopic, time.Duration) (strimzi.DeployResult, error)
+	deployAndWaitMutex       sync.RWMutex
+	deployAndWaitArgsForCall []struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+		arg3 time.Duration
+	}
+	deployAndWaitReturns struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}
+	deployAndWaitReturnsOnCall map[int]struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}
+	DeployWithResultStub        func(context.Context, v1beta2.KafkaTopic) (strimzi.DeployResult, error)
+	deployWithResultMutex       sync.RWMutex
+	deployWithResultArgsForCall []struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+	}
+	deployWithResultReturns struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}
+	deployWithResultReturnsOnCall map[int]struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}
+	UndeployStub        func(context.Context, string, string, ...strimzi.UndeployOption) error
 	undeployMutex       sync.RWMutex
 	undeployArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
+		arg4 []strimzi.UndeployOption
 	}
 	undeployReturns struct {
 		result1 error
@@ -35,6 +66,22 @@ type TopicDeployer struct {
 	undeployReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WaitForReadyStub        func(context.Context, string, string, time.Duration) (*v1beta2.KafkaTopic, error)
+	waitForReadyMutex       sync.RWMutex
+	waitForReadyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}
+	waitForReadyReturns struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
+	waitForReadyReturnsOnCall map[int]struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -101,20 +148,152 @@ func (fake *TopicDeployer) DeployReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *TopicDeployer) Undeploy(arg1 context.Context, arg2 string, arg3 string) error {
+func (fake *TopicDeployer) DeployAndWait(arg1 context.Context, arg2 v1beta2.KafkaTopic, arg3 time.Duration) (strimzi.DeployResult, error) {
+	fake.deployAndWaitMutex.Lock()
+	ret, specificReturn := fake.deployAndWaitReturnsOnCall[len(fake.deployAndWaitArgsForCall)]
+	fake.deployAndWaitArgsForCall = append(fake.deployAndWaitArgsForCall, struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.DeployAndWaitStub
+	fakeReturns := fake.deployAndWaitReturns
+	fake.recordInvocation("DeployAndWait", []interface{}{arg1, arg2, arg3})
+	fake.deployAndWaitMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicDeployer) DeployAndWaitCallCount() int {
+	fake.deployAndWaitMutex.RLock()
+	defer fake.deployAndWaitMutex.RUnlock()
+	return len(fake.deployAndWaitArgsForCall)
+}
+
+func (fake *TopicDeployer) DeployAndWaitCalls(stub func(context.Context, v1beta2.KafkaTopic, time.Duration) (strimzi.DeployResult, error)) {
+	fake.deployAndWaitMutex.Lock()
+	defer fake.deployAndWaitMutex.Unlock()
+	fake.DeployAndWaitStub = stub
+}
+
+func (fake *TopicDeployer) DeployAndWaitArgsForCall(i int) (context.Context, v1beta2.KafkaTopic, time.Duration) {
+	fake.deployAndWaitMutex.RLock()
+	defer fake.deployAndWaitMutex.RUnlock()
+	argsForCall := fake.deployAndWaitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *TopicDeployer) DeployAndWaitReturns(result1 strimzi.DeployResult, result2 error) {
+	fake.deployAndWaitMutex.Lock()
+	defer fake.deployAndWaitMutex.Unlock()
+	fake.DeployAndWaitStub = nil
+	fake.deployAndWaitReturns = struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicDeployer) DeployAndWaitReturnsOnCall(i int, result1 strimzi.DeployResult, result2 error) {
+	fake.deployAndWaitMutex.Lock()
+	defer fake.deployAndWaitMutex.Unlock()
+	fake.DeployAndWaitStub = nil
+	if fake.deployAndWaitReturnsOnCall == nil {
+		fake.deployAndWaitReturnsOnCall = make(map[int]struct {
+			result1 strimzi.DeployResult
+			result2 error
+		})
+	}
+	fake.deployAndWaitReturnsOnCall[i] = struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicDeployer) DeployWithResult(arg1 context.Context, arg2 v1beta2.KafkaTopic) (strimzi.DeployResult, error) {
+	fake.deployWithResultMutex.Lock()
+	ret, specificReturn := fake.deployWithResultReturnsOnCall[len(fake.deployWithResultArgsForCall)]
+	fake.deployWithResultArgsForCall = append(fake.deployWithResultArgsForCall, struct {
+		arg1 context.Context
+		arg2 v1beta2.KafkaTopic
+	}{arg1, arg2})
+	stub := fake.DeployWithResultStub
+	fakeReturns := fake.deployWithResultReturns
+	fake.recordInvocation("DeployWithResult", []interface{}{arg1, arg2})
+	fake.deployWithResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicDeployer) DeployWithResultCallCount() int {
+	fake.deployWithResultMutex.RLock()
+	defer fake.deployWithResultMutex.RUnlock()
+	return len(fake.deployWithResultArgsForCall)
+}
+
+func (fake *TopicDeployer) DeployWithResultCalls(stub func(context.Context, v1beta2.KafkaTopic) (strimzi.DeployResult, error)) {
+	fake.deployWithResultMutex.Lock()
+	defer fake.deployWithResultMutex.Unlock()
+	fake.DeployWithResultStub = stub
+}
+
+func (fake *TopicDeployer) DeployWithResultArgsForCall(i int) (context.Context, v1beta2.KafkaTopic) {
+	fake.deployWithResultMutex.RLock()
+	defer fake.deployWithResultMutex.RUnlock()
+	argsForCall := fake.deployWithResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *TopicDeployer) DeployWithResultReturns(result1 strimzi.DeployResult, result2 error) {
+	fake.deployWithResultMutex.Lock()
+	defer fake.deployWithResultMutex.Unlock()
+	fake.DeployWithResultStub = nil
+	fake.deployWithResultReturns = struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicDeployer) DeployWithResultReturnsOnCall(i int, result1 strimzi.DeployResult, result2 error) {
+	fake.deployWithResultMutex.Lock()
+	defer fake.deployWithResultMutex.Unlock()
+	fake.DeployWithResultStub = nil
+	if fake.deployWithResultReturnsOnCall == nil {
+		fake.deployWithResultReturnsOnCall = make(map[int]struct {
+			result1 strimzi.DeployResult
+			result2 error
+		})
+	}
+	fake.deployWithResultReturnsOnCall[i] = struct {
+		result1 strimzi.DeployResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicDeployer) Undeploy(arg1 context.Context, arg2 string, arg3 string, arg4 ...strimzi.UndeployOption) error {
 	fake.undeployMutex.Lock()
 	ret, specificReturn := fake.undeployReturnsOnCall[len(fake.undeployArgsForCall)]
 	fake.undeployArgsForCall = append(fake.undeployArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 []strimzi.UndeployOption
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.UndeployStub
 	fakeReturns := fake.undeployReturns
-	fake.recordInvocation("Undeploy", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Undeploy", []interface{}{arg1, arg2, arg3, arg4})
 	fake.undeployMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1
@@ -128,17 +307,17 @@ func (fake *TopicDeployer) UndeployCallCount() int {
 	return len(fake.undeployArgsForCall)
 }
 
-func (fake *TopicDeployer) UndeployCalls(stub func(context.Context, string, string) error) {
+func (fake *TopicDeployer) UndeployCalls(stub func(context.Context, string, string, ...strimzi.UndeployOption) error) {
 	fake.undeployMutex.Lock()
 	defer fake.undeployMutex.Unlock()
 	fake.UndeployStub = stub
 }
 
-func (fake *TopicDeployer) UndeployArgsForCall(i int) (context.Context, string, string) {
+func (fake *TopicDeployer) UndeployArgsForCall(i int) (context.Context, string, string, []strimzi.UndeployOption) {
 	fake.undeployMutex.RLock()
 	defer fake.undeployMutex.RUnlock()
 	argsForCall := fake.undeployArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *TopicDeployer) UndeployReturns(result1 error) {
@@ -164,6 +343,73 @@ func (fake *TopicDeployer) UndeployReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *TopicDeployer) WaitForReady(arg1 context.Context, arg2 string, arg3 string, arg4 time.Duration) (*v1beta2.KafkaTopic, error) {
+	fake.waitForReadyMutex.Lock()
+	ret, specificReturn := fake.waitForReadyReturnsOnCall[len(fake.waitForReadyArgsForCall)]
+	fake.waitForReadyArgsForCall = append(fake.waitForReadyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.WaitForReadyStub
+	fakeReturns := fake.waitForReadyReturns
+	fake.recordInvocation("WaitForReady", []interface{}{arg1, arg2, arg3, arg4})
+	fake.waitForReadyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicDeployer) WaitForReadyCallCount() int {
+	fake.waitForReadyMutex.RLock()
+	defer fake.waitForReadyMutex.RUnlock()
+	return len(fake.waitForReadyArgsForCall)
+}
+
+func (fake *TopicDeployer) WaitForReadyCalls(stub func(context.Context, string, string, time.Duration) (*v1beta2.KafkaTopic, error)) {
+	fake.waitForReadyMutex.Lock()
+	defer fake.waitForReadyMutex.Unlock()
+	fake.WaitForReadyStub = stub
+}
+
+func (fake *TopicDeployer) WaitForReadyArgsForCall(i int) (context.Context, string, string, time.Duration) {
+	fake.waitForReadyMutex.RLock()
+	defer fake.waitForReadyMutex.RUnlock()
+	argsForCall := fake.waitForReadyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *TopicDeployer) WaitForReadyReturns(result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.waitForReadyMutex.Lock()
+	defer fake.waitForReadyMutex.Unlock()
+	fake.WaitForReadyStub = nil
+	fake.waitForReadyReturns = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicDeployer) WaitForReadyReturnsOnCall(i int, result1 *v1beta2.KafkaTopic, result2 error) {
+	fake.waitForReadyMutex.Lock()
+	defer fake.waitForReadyMutex.Unlock()
+	fake.WaitForReadyStub = nil
+	if fake.waitForReadyReturnsOnCall == nil {
+		fake.waitForReadyReturnsOnCall = make(map[int]struct {
+			result1 *v1beta2.KafkaTopic
+			result2 error
+		})
+	}
+	fake.waitForReadyReturnsOnCall[i] = struct {
+		result1 *v1beta2.KafkaTopic
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *TopicDeployer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type TopicSetReconciler struct {
+	ReconcileStub        func(context.Context, string, labels.Selector, v1beta2.KafkaTopics) (strimzi.TopicSetResult, error)
+	reconcileMutex       sync.RWMutex
+	reconcileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 labels.Selector
+		arg4 v1beta2.KafkaTopics
+	}
+	reconcileReturns struct {
+		result1 strimzi.TopicSetResult
+		result2 error
+	}
+	reconcileReturnsOnCall map[int]struct {
+		result1 strimzi.TopicSetResult
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *TopicSetReconciler) Reconcile(arg1 context.Context, arg2 string, arg3 labels.Selector, arg4 v1beta2.KafkaTopics) (strimzi.TopicSetResult, error) {
+	fake.reconcileMutex.Lock()
+	ret, specificReturn := fake.reconcileReturnsOnCall[len(fake.reconcileArgsForCall)]
+	fake.reconcileArgsForCall = append(fake.reconcileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 labels.Selector
+		arg4 v1beta2.KafkaTopics
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReconcileStub
+	fakeReturns := fake.reconcileReturns
+	fake.recordInvocation("Reconcile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.reconcileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *TopicSetReconciler) ReconcileCallCount() int {
+	fake.reconcileMutex.RLock()
+	defer fake.reconcileMutex.RUnlock()
+	return len(fake.reconcileArgsForCall)
+}
+
+func (fake *TopicSetReconciler) ReconcileCalls(stub func(context.Context, string, labels.Selector, v1beta2.KafkaTopics) (strimzi.TopicSetResult, error)) {
+	fake.reconcileMutex.Lock()
+	defer fake.reconcileMutex.Unlock()
+	fake.ReconcileStub = stub
+}
+
+func (fake *TopicSetReconciler) ReconcileArgsForCall(i int) (context.Context, string, labels.Selector, v1beta2.KafkaTopics) {
+	fake.reconcileMutex.RLock()
+	defer fake.reconcileMutex.RUnlock()
+	argsForCall := fake.reconcileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *TopicSetReconciler) ReconcileReturns(result1 strimzi.TopicSetResult, result2 error) {
+	fake.reconcileMutex.Lock()
+	defer fake.reconcileMutex.Unlock()
+	fake.ReconcileStub = nil
+	fake.reconcileReturns = struct {
+		result1 strimzi.TopicSetResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicSetReconciler) ReconcileReturnsOnCall(i int, result1 strimzi.TopicSetResult, result2 error) {
+	fake.reconcileMutex.Lock()
+	defer fake.reconcileMutex.Unlock()
+	fake.ReconcileStub = nil
+	if fake.reconcileReturnsOnCall == nil {
+		fake.reconcileReturnsOnCall = make(map[int]struct {
+			result1 strimzi.TopicSetResult
+			result2 error
+		})
+	}
+	fake.reconcileReturnsOnCall[i] = struct {
+		result1 strimzi.TopicSetResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *TopicSetReconciler) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *TopicSetReconciler) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ strimzi.TopicSetReconciler = new(TopicSetReconciler)
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bborbe/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+//counterfeiter:generate -o mocks/cluster-resolver.go --fake-name ClusterResolver . ClusterResolver
+
+// ClusterResolver picks the Kafka cluster of a KafkaTopic without the
+// v1beta2.ClusterLabel, see WithClusterResolver.
+type ClusterResolver interface {
+	// ResolveCluster returns the name of the Kafka cluster the topic belongs to.
+	ResolveCluster(ctx context.Context, topic v1beta2.KafkaTopic) (string, error)
+}
+
+// ClusterResolverFunc allows to use a function as ClusterResolver, e.g. to pick the
+// cluster by a prefix of the topic name in namespaces with several clusters.
+type ClusterResolverFunc func(ctx context.Context, topic v1beta2.KafkaTopic) (string, error)
+
+// ResolveCluster calls the function.
+func (c ClusterResolverFunc) ResolveCluster(
+	ctx context.Context,
+	topic v1beta2.KafkaTopic,
+) (string, error) {
+	return c(ctx, topic)
+}
+
+// NewStaticClusterResolver returns a ClusterResolver that always picks the given cluster.
+func NewStaticClusterResolver(cluster string) ClusterResolver {
+	return ClusterResolverFunc(func(ctx context.Context, topic v1beta2.KafkaTopic) (string, error) {
+		return cluster, nil
+	})
+}
+
+// NewNamespaceClusterResolver returns a ClusterResolver that picks the only Kafka
+// cluster in the namespace of the topic. It fails with ErrTopicClusterNotFound if the
+// namespace has no Kafka cluster and with ErrTopicClusterAmbiguous if it has several.
+//
+// Parameters:
+//   - clientset: Strimzi clientset used to list the Kafka clusters
+//   - apiVersion: The kafka.strimzi.io API version used to list the Kafka clusters
+//
+// Returns:
+//   - ClusterResolver: A resolver for namespaces with a single Kafka cluster
+func NewNamespaceClusterResolver(
+	clientset versioned.Interface,
+	apiVersion APIVersion,
+) ClusterResolver {
+	clusterClient := newClusterClient(clientset, apiVersion)
+	return ClusterResolverFunc(func(ctx context.Context, topic v1beta2.KafkaTopic) (string, error) {
+		clusters, err := clusterClient.List(ctx, topic.Namespace)
+		if err != nil {
+			return "", errors.Wrapf(
+				ctx,
+				topicError(err),
+				"list kafka clusters in namespace %s failed",
+				topic.Namespace,
+			)
+		}
+		switch len(clusters) {
+		case 0:
+			return "", errors.Wrapf(
+				ctx,
+				ErrTopicClusterNotFound,
+				"no kafka cluster in namespace %s",
+				topic.Namespace,
+			)
+		case 1:
+			return clusters[0], nil
+		default:
+			return "", errors.Wrapf(
+				ctx,
+				ErrTopicClusterAmbiguous,
+				"kafka clusters %s in namespace %s",
+				strings.Join(clusters, ","),
+				topic.Namespace,
+			)
+		}
+	})
+}
+
+// clusterClient reads the Kafka clusters in one API version.
+type clusterClient interface {
+	// Exists returns true if the Kafka cluster with the given name exists.
+	Exists(ctx context.Context, namespace string, name string) (bool, error)
+	// List returns the sorted names of all Kafka clusters in the namespace.
+	List(ctx context.Context, namespace string) ([]string, error)
+}
+
+func newClusterClient(clientset versioned.Interface, apiVersion APIVersion) clusterClient {
+	switch apiVersion {
+	case APIVersionV1:
+		return &clusterClientV1{clientset: clientset}
+	case APIVersionV1beta2, "":
+		return &clusterClientV1beta2{clientset: clientset}
+	default:
+		return &clusterClientUnsupported{apiVersion: apiVersion}
+	}
+}
+
+type clusterClientV1beta2 struct {
+	clientset versioned.Interface
+}
+
+func (c *clusterClientV1beta2) Exists(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (bool, error) {
+	_, err := c.clientset.KafkaV1beta2().Kafkas(namespace).Get(ctx, name, metav1.GetOptions{})
+	return clusterExists(err)
+}
+
+func (c *clusterClientV1beta2) List(ctx context.Context, namespace string) ([]string, error) {
+	list, err := c.clientset.KafkaV1beta2().Kafkas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(list.Items))
+	for _, kafka := range list.Items {
+		result = append(result, kafka.Name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+type clusterClientV1 struct {
+	clientset versioned.Interface
+}
+
+func (c *clusterClientV1) Exists(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (bool, error) {
+	_, err := c.clientset.KafkaV1().Kafkas(namespace).Get(ctx, name, metav1.GetOptions{})
+	return clusterExists(err)
+}
+
+func (c *clusterClientV1) List(ctx context.Context, namespace string) ([]string, error) {
+	list, err := c.clientset.KafkaV1().Kafkas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(list.Items))
+	for _, kafka := range list.Items {
+		result = append(result, kafka.Name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+type clusterClientUnsupported struct {
+	apiVersion APIVersion
+}
+
+func (c *clusterClientUnsupported) Exists(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (bool, error) {
+	return false, errors.Errorf(ctx, "unsupported api version '%s'", c.apiVersion)
+}
+
+func (c *clusterClientUnsupported) List(ctx context.Context, namespace string) ([]string, error) {
+	return nil, errors.Errorf(ctx, "unsupported api version '%s'", c.apiVersion)
+}
+
+func clusterExists(err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case apierrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
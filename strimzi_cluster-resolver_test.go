@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi"
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("ClusterResolver", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var topic v1beta2.KafkaTopic
+	var cluster string
+	var err error
+
+	createKafka := func(name string) {
+		_, err := clientset.KafkaV1beta2().
+			Kafkas("kafka").
+			Create(ctx, &v1beta2.Kafka{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kafka"},
+			}, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		topic = v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "kafka"},
+		}
+	})
+
+	It("resolves the static cluster", func() {
+		cluster, err = strimzi.NewStaticClusterResolver("my-cluster").ResolveCluster(ctx, topic)
+		Expect(err).To(BeNil())
+		Expect(cluster).To(Equal("my-cluster"))
+	})
+
+	Context("NamespaceClusterResolver", func() {
+		JustBeforeEach(func() {
+			cluster, err = strimzi.NewNamespaceClusterResolver(clientset, strimzi.APIVersionV1beta2).
+				ResolveCluster(ctx, topic)
+		})
+		Context("one cluster", func() {
+			BeforeEach(func() {
+				createKafka("my-cluster")
+			})
+			It("returns the cluster", func() {
+				Expect(err).To(BeNil())
+				Expect(cluster).To(Equal("my-cluster"))
+			})
+		})
+		Context("no cluster", func() {
+			It("returns ErrTopicClusterNotFound", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicClusterNotFound)).To(BeTrue())
+			})
+		})
+		Context("several clusters", func() {
+			BeforeEach(func() {
+				createKafka("cluster-b")
+				createKafka("cluster-a")
+			})
+			It("returns ErrTopicClusterAmbiguous", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicClusterAmbiguous)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("cluster-a,cluster-b"))
+			})
+		})
+	})
+
+	It("lists clusters with the v1 api", func() {
+		// the v1 Kafka client is read-only
+		err := clientset.Tracker().Create(
+			kafkav1.SchemeGroupVersion.WithResource("kafkas"),
+			&kafkav1.Kafka{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "kafka"},
+			},
+			"kafka",
+		)
+		Expect(err).To(BeNil())
+		cluster, err = strimzi.NewNamespaceClusterResolver(clientset, strimzi.APIVersionV1).
+			ResolveCluster(ctx, topic)
+		Expect(err).To(BeNil())
+		Expect(cluster).To(Equal("my-cluster"))
+	})
+})
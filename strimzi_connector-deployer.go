@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+//counterfeiter:generate -o mocks/connector-deployer.go --fake-name ConnectorDeployer . ConnectorDeployer
+
+// ConnectorDeployer provides operations for deploying and managing Kafka connectors in
+// Kubernetes. It handles both creation and updates of KafkaConnector custom resources,
+// as well as their removal.
+type ConnectorDeployer interface {
+	// Deploy creates or updates a KafkaConnector resource in Kubernetes.
+	// If the connector doesn't exist, it will be created. If it exists, it will be updated
+	// with the new configuration while preserving the resource version.
+	// Errors other than NotFound are returned, marked with ErrConnectorForbidden or
+	// ErrConnectorConflict where applicable.
+	Deploy(ctx context.Context, connector v1beta2.KafkaConnector) error
+
+	// Undeploy removes a KafkaConnector resource from Kubernetes.
+	// If the connector doesn't exist, the operation succeeds silently. Other errors are
+	// returned, marked with ErrConnectorForbidden where applicable.
+	Undeploy(ctx context.Context, namespace string, name string) error
+}
+
+// NewConnectorDeployer creates a new ConnectorDeployer instance.
+//
+// Parameters:
+//   - clientset: Strimzi clientset for interacting with KafkaConnector resources
+//
+// Returns:
+//   - ConnectorDeployer: A new deployer instance for managing Kafka connectors
+func NewConnectorDeployer(
+	clientset versioned.Interface,
+) ConnectorDeployer {
+	return &connectorDeployer{
+		clientset: clientset,
+	}
+}
+
+type connectorDeployer struct {
+	clientset versioned.Interface
+}
+
+var connectorKind = resourceKind{name: "connector", markError: connectorError}
+
+func (c *connectorDeployer) Deploy(ctx context.Context, connector v1beta2.KafkaConnector) error {
+	return deployResource(
+		ctx,
+		c.clientset.KafkaV1beta2().KafkaConnectors(connector.Namespace),
+		connectorKind,
+		&connector,
+	)
+}
+
+func (c *connectorDeployer) Undeploy(ctx context.Context, namespace string, name string) error {
+	return undeployResource(
+		ctx,
+		c.clientset.KafkaV1beta2().KafkaConnectors(namespace),
+		connectorKind,
+		name,
+	)
+}
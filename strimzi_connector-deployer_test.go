@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("ConnectorDeployer", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var connectorDeployer strimzi.ConnectorDeployer
+	var connector v1beta2.KafkaConnector
+
+	connectorResource := v1beta2.Resource("kafkaconnectors")
+	reactWith := func(verb string, reactErr error) {
+		clientset.PrependReactor(
+			verb,
+			"kafkaconnectors",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, reactErr
+			},
+		)
+	}
+	getConnector := func() *v1beta2.KafkaConnector {
+		result, err := clientset.KafkaV1beta2().
+			KafkaConnectors("kafka").
+			Get(ctx, "my-connector", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		connectorDeployer = strimzi.NewConnectorDeployer(clientset)
+		connector = v1beta2.KafkaConnector{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-connector",
+				Namespace: "kafka",
+			},
+			Spec: &v1beta2.KafkaConnectorSpec{
+				Class:    collection.Ptr("org.apache.kafka.connect.file.FileStreamSourceConnector"),
+				TasksMax: collection.Ptr(int32(2)),
+				State:    collection.Ptr(v1beta2.KafkaConnectorStateRunning),
+				Config: &runtime.RawExtension{
+					Raw: []byte(`{"file":"/opt/kafka/LICENSE","topic":"my-topic"}`),
+				},
+			},
+		}
+	})
+
+	It("creates the connector with its class and config", func() {
+		Expect(connectorDeployer.Deploy(ctx, connector)).To(Succeed())
+		result := getConnector()
+		Expect(*result.Spec.Class).To(Equal(*connector.Spec.Class))
+		Expect(string(result.Spec.Config.Raw)).To(ContainSubstring(`"topic":"my-topic"`))
+	})
+
+	It("resumes a paused connector", func() {
+		existing := connector.DeepCopy()
+		existing.Spec.State = collection.Ptr(v1beta2.KafkaConnectorStatePaused)
+		_, err := clientset.KafkaV1beta2().
+			KafkaConnectors("kafka").
+			Create(ctx, existing, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+
+		Expect(connectorDeployer.Deploy(ctx, connector)).To(Succeed())
+		Expect(*getConnector().Spec.State).To(Equal(v1beta2.KafkaConnectorStateRunning))
+	})
+
+	It("returns ErrConnectorForbidden if get is forbidden", func() {
+		reactWith(
+			"get",
+			apierrors.NewForbidden(connectorResource, "my-connector", stderrors.New("rbac")),
+		)
+		err := connectorDeployer.Deploy(ctx, connector)
+		Expect(stderrors.Is(err, strimzi.ErrConnectorForbidden)).To(BeTrue())
+	})
+
+	It("returns ErrConnectorConflict if the connector is created concurrently", func() {
+		reactWith("create", apierrors.NewAlreadyExists(connectorResource, "my-connector"))
+		err := connectorDeployer.Deploy(ctx, connector)
+		Expect(stderrors.Is(err, strimzi.ErrConnectorConflict)).To(BeTrue())
+	})
+
+	It("deletes the connector", func() {
+		_, err := clientset.KafkaV1beta2().
+			KafkaConnectors("kafka").
+			Create(ctx, &connector, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+
+		Expect(connectorDeployer.Undeploy(ctx, "kafka", "my-connector")).To(Succeed())
+		_, err = clientset.KafkaV1beta2().
+			KafkaConnectors("kafka").
+			Get(ctx, "my-connector", metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("returns ErrConnectorForbidden if the undeploy is forbidden", func() {
+		reactWith(
+			"get",
+			apierrors.NewForbidden(connectorResource, "my-connector", stderrors.New("rbac")),
+		)
+		err := connectorDeployer.Undeploy(ctx, "kafka", "my-connector")
+		Expect(stderrors.Is(err, strimzi.ErrConnectorForbidden)).To(BeTrue())
+	})
+})
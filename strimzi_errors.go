@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// ErrTopicForbidden is returned if the API server rejects a KafkaTopic request
+	// because of missing RBAC permissions.
+	ErrTopicForbidden = stderrors.New("topic forbidden")
+
+	// ErrTopicConflict is returned if a KafkaTopic was modified concurrently or
+	// already exists.
+	ErrTopicConflict = stderrors.New("topic conflict")
+
+	// ErrTopicFieldManagerConflict is returned if a server-side apply of a KafkaTopic
+	// changes fields owned by another field manager. It also matches ErrTopicConflict.
+	ErrTopicFieldManagerConflict = stderrors.New("topic field manager conflict")
+
+	// ErrTopicPartitionDecrease is returned if a deploy would decrease the partitions
+	// of an existing KafkaTopic, which Kafka does not support.
+	ErrTopicPartitionDecrease = stderrors.New("topic partition decrease")
+
+	// ErrTopicReplicasChange is returned if a deploy would change the replicas of an
+	// existing KafkaTopic, which the topic operator only supports with Cruise Control.
+	ErrTopicReplicasChange = stderrors.New("topic replicas change")
+
+	// ErrTopicNotReady is returned if the topic operator reports the Ready condition
+	// of a reconciled KafkaTopic as False.
+	ErrTopicNotReady = stderrors.New("topic not ready")
+
+	// ErrTopicProtected is returned if Undeploy refuses to delete a protected KafkaTopic.
+	// Pass WithForce to delete it anyway.
+	ErrTopicProtected = stderrors.New("topic protected")
+
+	// ErrTopicDeletionStuck is returned if a deleted KafkaTopic still exists after the
+	// wait timeout, usually because a finalizer was not removed.
+	ErrTopicDeletionStuck = stderrors.New("topic deletion stuck")
+
+	// ErrTopicConfigInvalid is returned if WithConfigValidation is set and the config of
+	// a KafkaTopic has unknown keys or invalid values.
+	ErrTopicConfigInvalid = stderrors.New("topic config invalid")
+
+	// ErrTopicClusterMissing is returned if WithClusterValidation is set and a KafkaTopic
+	// has no strimzi.io/cluster label, so the topic operator would ignore it.
+	ErrTopicClusterMissing = stderrors.New("topic cluster missing")
+
+	// ErrTopicClusterNotFound is returned if the Kafka cluster of a KafkaTopic does not
+	// exist in its namespace.
+	ErrTopicClusterNotFound = stderrors.New("topic cluster not found")
+
+	// ErrTopicClusterAmbiguous is returned by NewNamespaceClusterResolver if the
+	// namespace has several Kafka clusters.
+	ErrTopicClusterAmbiguous = stderrors.New("topic cluster ambiguous")
+
+	// ErrUserForbidden is returned if the API server rejects a KafkaUser request
+	// because of missing RBAC permissions.
+	ErrUserForbidden = stderrors.New("user forbidden")
+
+	// ErrUserConflict is returned if a KafkaUser was modified concurrently or already
+	// exists.
+	ErrUserConflict = stderrors.New("user conflict")
+
+	// ErrConnectorForbidden is returned if the API server rejects a KafkaConnector
+	// request because of missing RBAC permissions.
+	ErrConnectorForbidden = stderrors.New("connector forbidden")
+
+	// ErrConnectorConflict is returned if a KafkaConnector was modified concurrently or
+	// already exists.
+	ErrConnectorConflict = stderrors.New("connector conflict")
+)
+
+// topicError marks err with the matching topic sentinel error, so callers can use
+// errors.Is. The original API error stays accessible through errors.As and the
+// apierrors.IsXxx helpers.
+func topicError(err error) error {
+	switch {
+	case isFieldManagerConflict(err):
+		return fmt.Errorf("%w: %w: %w", ErrTopicFieldManagerConflict, ErrTopicConflict, err)
+	default:
+		return markError(err, ErrTopicForbidden, ErrTopicConflict)
+	}
+}
+
+// userError marks err with the matching KafkaUser sentinel error.
+func userError(err error) error {
+	return markError(err, ErrUserForbidden, ErrUserConflict)
+}
+
+// connectorError marks err with the matching KafkaConnector sentinel error.
+func connectorError(err error) error {
+	return markError(err, ErrConnectorForbidden, ErrConnectorConflict)
+}
+
+// markError marks forbidden errors with the forbidden sentinel and conflicts with the
+// conflict sentinel of a resource.
+func markError(err error, forbidden error, conflict error) error {
+	switch {
+	case apierrors.IsForbidden(err):
+		return fmt.Errorf("%w: %w", forbidden, err)
+	case apierrors.IsConflict(err), apierrors.IsAlreadyExists(err):
+		return fmt.Errorf("%w: %w", conflict, err)
+	default:
+		return err
+	}
+}
+
+func isFieldManagerConflict(err error) bool {
+	if !apierrors.IsConflict(err) {
+		return false
+	}
+	_, ok := apierrors.StatusCause(err, metav1.CauseTypeFieldManagerConflict)
+	return ok
+}
+
+// isRetryableConflict returns true if err was caused by a concurrent modification
+// that is resolved by reading the topic again. Field manager conflicts of server-side
+// apply are not retryable.
+func isRetryableConflict(err error) bool {
+	if isFieldManagerConflict(err) {
+		return false
+	}
+	return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+}
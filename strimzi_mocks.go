@@ -4,7 +4,10 @@
 
 package strimzi
 
-import "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+import (
+	kafkav1 "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.strimzi.io/v1beta2"
+)
 
 //counterfeiter:generate -o mocks/kafka-v1-beta2-interface.go --fake-name KafkaV1beta2Interface . KafkaV1beta2Interface
 
@@ -12,8 +15,62 @@ import "github.com/bborbe/strimzi/k8s/client/clientset/versioned/typed/kafka.str
 // that enables mock generation using counterfeiter.
 type KafkaV1beta2Interface v1beta2.KafkaV1beta2Interface
 
+//counterfeiter:generate -o mocks/kafka-v1-interface.go --fake-name KafkaV1Interface . KafkaV1Interface
+
+// KafkaV1Interface is a type alias for v1.KafkaV1Interface
+// that enables mock generation using counterfeiter.
+type KafkaV1Interface kafkav1.KafkaV1Interface
+
 //counterfeiter:generate -o mocks/kafka-topic-interface.go --fake-name KafkaTopicInterface . KafkaTopicInterface
 
 // KafkaTopicInterface is a type alias for v1beta2.KafkaTopicInterface
 // that enables mock generation using counterfeiter.
 type KafkaTopicInterface = v1beta2.KafkaTopicInterface
+
+//counterfeiter:generate -o mocks/kafka-interface.go --fake-name KafkaInterface . KafkaInterface
+
+// KafkaInterface is a type alias for v1beta2.KafkaInterface
+// that enables mock generation using counterfeiter.
+type KafkaInterface = v1beta2.KafkaInterface
+
+//counterfeiter:generate -o mocks/kafka-user-interface.go --fake-name KafkaUserInterface . KafkaUserInterface
+
+// KafkaUserInterface is a type alias for v1beta2.KafkaUserInterface
+// that enables mock generation using counterfeiter.
+type KafkaUserInterface = v1beta2.KafkaUserInterface
+
+//counterfeiter:generate -o mocks/kafka-connect-interface.go --fake-name KafkaConnectInterface . KafkaConnectInterface
+
+// KafkaConnectInterface is a type alias for v1beta2.KafkaConnectInterface
+// that enables mock generation using counterfeiter.
+type KafkaConnectInterface = v1beta2.KafkaConnectInterface
+
+//counterfeiter:generate -o mocks/kafka-connector-interface.go --fake-name KafkaConnectorInterface . KafkaConnectorInterface
+
+// KafkaConnectorInterface is a type alias for v1beta2.KafkaConnectorInterface
+// that enables mock generation using counterfeiter.
+type KafkaConnectorInterface = v1beta2.KafkaConnectorInterface
+
+//counterfeiter:generate -o mocks/kafka-mirror-maker-2-interface.go --fake-name KafkaMirrorMaker2Interface . KafkaMirrorMaker2Interface
+
+// KafkaMirrorMaker2Interface is a type alias for v1beta2.KafkaMirrorMaker2Interface
+// that enables mock generation using counterfeiter.
+type KafkaMirrorMaker2Interface = v1beta2.KafkaMirrorMaker2Interface
+
+//counterfeiter:generate -o mocks/kafka-rebalance-interface.go --fake-name KafkaRebalanceInterface . KafkaRebalanceInterface
+
+// KafkaRebalanceInterface is a type alias for v1beta2.KafkaRebalanceInterface
+// that enables mock generation using counterfeiter.
+type KafkaRebalanceInterface = v1beta2.KafkaRebalanceInterface
+
+//counterfeiter:generate -o mocks/kafka-node-pool-interface.go --fake-name KafkaNodePoolInterface . KafkaNodePoolInterface
+
+// KafkaNodePoolInterface is a type alias for v1beta2.KafkaNodePoolInterface
+// that enables mock generation using counterfeiter.
+type KafkaNodePoolInterface = v1beta2.KafkaNodePoolInterface
+
+//counterfeiter:generate -o mocks/kafka-bridge-interface.go --fake-name KafkaBridgeInterface . KafkaBridgeInterface
+
+// KafkaBridgeInterface is a type alias for v1beta2.KafkaBridgeInterface
+// that enables mock generation using counterfeiter.
+type KafkaBridgeInterface = v1beta2.KafkaBridgeInterface
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+//counterfeiter:generate -o mocks/rebalance-manager.go --fake-name RebalanceManager . RebalanceManager
+
+// RebalanceManager drives Cruise Control rebalances through KafkaRebalance resources.
+// Actions are triggered by setting the strimzi.io/rebalance annotation, the same way
+// `kubectl annotate` would do it.
+type RebalanceManager interface {
+	// Request creates a KafkaRebalance resource, which asks Cruise Control for an
+	// optimization proposal. If the resource already exists, its spec is updated and
+	// a refresh of the proposal is requested.
+	Request(ctx context.Context, rebalance v1beta2.KafkaRebalance) error
+
+	// WaitForProposal blocks until Cruise Control has calculated the optimization
+	// proposal of the given KafkaRebalance and returns it. While the strimzi.io/rebalance
+	// annotation is present the operator has not handled the last action yet and the
+	// state is ignored, so a proposal from before Request or Refresh is never returned.
+	// Only ProposalReady counts as ready. An error is returned if the rebalance becomes
+	// NotReady or Stopped, or the timeout expires.
+	WaitForProposal(
+		ctx context.Context,
+		namespace string,
+		name string,
+		timeout time.Duration,
+	) (*v1beta2.KafkaRebalance, error)
+
+	// Approve starts the rebalance based on the current proposal.
+	Approve(ctx context.Context, namespace string, name string) error
+
+	// Refresh requests a new optimization proposal.
+	Refresh(ctx context.Context, namespace string, name string) error
+
+	// Stop cancels a running rebalance.
+	Stop(ctx context.Context, namespace string, name string) error
+}
+
+// NewRebalanceManager creates a new RebalanceManager instance.
+//
+// Parameters:
+//   - clientset: Strimzi clientset for interacting with KafkaRebalance resources
+//
+// Returns:
+//   - RebalanceManager: A new manager for Cruise Control rebalances
+func NewRebalanceManager(
+	clientset versioned.Interface,
+) RebalanceManager {
+	return &rebalanceManager{
+		clientset: clientset,
+	}
+}
+
+type rebalanceManager struct {
+	clientset versioned.Interface
+}
+
+func (r *rebalanceManager) Request(ctx context.Context, rebalance v1beta2.KafkaRebalance) error {
+	currentRebalance, err := r.clientset.KafkaV1beta2().
+		KafkaRebalances(rebalance.Namespace).
+		Get(ctx, rebalance.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(ctx, err, "get rebalance %s failed", rebalance.Name)
+		}
+		_, err = r.clientset.KafkaV1beta2().
+			KafkaRebalances(rebalance.Namespace).
+			Create(ctx, &rebalance, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(ctx, err, "create rebalance %s failed", rebalance.Name)
+		}
+		glog.V(3).Infof("rebalance %s created successful", rebalance.Name)
+		return nil
+	}
+	rebalance.ResourceVersion = currentRebalance.ResourceVersion
+	// the status belongs to the operator, keep it like the API server does
+	rebalance.Status = currentRebalance.Status
+	if rebalance.Annotations == nil {
+		rebalance.Annotations = map[string]string{}
+	}
+	rebalance.Annotations[v1beta2.RebalanceAnnotation] = v1beta2.RebalanceActionRefresh
+	_, err = r.clientset.KafkaV1beta2().
+		KafkaRebalances(rebalance.Namespace).
+		Update(ctx, &rebalance, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrapf(ctx, err, "update rebalance %s failed", rebalance.Name)
+	}
+	glog.V(3).Infof("rebalance %s updated and refresh requested", rebalance.Name)
+	return nil
+}
+
+func (r *rebalanceManager) WaitForProposal(
+	ctx context.Context,
+	namespace string,
+	name string,
+	timeout time.Duration,
+) (*v1beta2.KafkaRebalance, error) {
+	client := r.clientset.KafkaV1beta2().KafkaRebalances(namespace)
+	event, err := waitUntil(
+		ctx,
+		timeout,
+		newNameListWatch(
+			r.clientset,
+			name,
+			func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+				return client.List(ctx, opts)
+			},
+			client.Watch,
+		),
+		&v1beta2.KafkaRebalance{},
+		nil,
+		func(event watch.Event) (bool, error) {
+			rebalance, ok := event.Object.(*v1beta2.KafkaRebalance)
+			if !ok || rebalance.Name != name {
+				return false, nil
+			}
+			if event.Type == watch.Deleted {
+				return false, errors.Errorf(ctx, "rebalance %s deleted", name)
+			}
+			if action, ok := rebalance.Annotations[v1beta2.RebalanceAnnotation]; ok {
+				// the operator removes the annotation after it updated the status
+				glog.V(3).Infof("rebalance %s has pending action %s => wait", name, action)
+				return false, nil
+			}
+			switch rebalance.State() {
+			case v1beta2.KafkaRebalanceStateProposalReady:
+				return true, nil
+			case v1beta2.KafkaRebalanceStateNotReady, v1beta2.KafkaRebalanceStateStopped:
+				return false, errors.Errorf(
+					ctx,
+					"rebalance %s is %s: %s",
+					name,
+					rebalance.State(),
+					conditionMessage(rebalance.Condition()),
+				)
+			default:
+				return false, nil
+			}
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "wait for proposal of rebalance %s failed", name)
+	}
+	return event.Object.(*v1beta2.KafkaRebalance), nil
+}
+
+func (r *rebalanceManager) Approve(ctx context.Context, namespace string, name string) error {
+	return r.annotate(ctx, namespace, name, v1beta2.RebalanceActionApprove)
+}
+
+func (r *rebalanceManager) Refresh(ctx context.Context, namespace string, name string) error {
+	return r.annotate(ctx, namespace, name, v1beta2.RebalanceActionRefresh)
+}
+
+func (r *rebalanceManager) Stop(ctx context.Context, namespace string, name string) error {
+	return r.annotate(ctx, namespace, name, v1beta2.RebalanceActionStop)
+}
+
+func (r *rebalanceManager) annotate(
+	ctx context.Context,
+	namespace string,
+	name string,
+	action string,
+) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				v1beta2.RebalanceAnnotation: action,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(ctx, err, "marshal patch failed")
+	}
+	_, err = r.clientset.KafkaV1beta2().
+		KafkaRebalances(namespace).
+		Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return errors.Wrapf(ctx, err, "%s rebalance %s failed", action, name)
+	}
+	glog.V(3).Infof("%s rebalance %s successful", action, name)
+	return nil
+}
+
+func conditionMessage(condition *v1beta2.Condition) string {
+	if condition == nil {
+		return ""
+	}
+	var reason, message string
+	if condition.Reason != nil {
+		reason = *condition.Reason
+	}
+	if condition.Message != nil {
+		message = *condition.Message
+	}
+	if reason == "" {
+		return message
+	}
+	return reason + ": " + message
+}
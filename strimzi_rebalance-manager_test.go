@@ -0,0 +1,310 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("RebalanceManager", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var rebalanceManager strimzi.RebalanceManager
+	var rebalance v1beta2.KafkaRebalance
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		rebalanceManager = strimzi.NewRebalanceManager(clientset)
+		rebalance = v1beta2.KafkaRebalance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-rebalance",
+				Namespace: "kafka",
+				Labels: map[string]string{
+					"strimzi.io/cluster": "my-cluster",
+				},
+			},
+			Spec: &v1beta2.KafkaRebalanceSpec{
+				Mode: collection.Ptr(v1beta2.KafkaRebalanceModeFull),
+			},
+		}
+	})
+
+	withState := func(state v1beta2.KafkaRebalanceState) *v1beta2.KafkaRebalance {
+		result := rebalance.DeepCopy()
+		result.Status = &v1beta2.KafkaRebalanceStatus{
+			Conditions: []v1beta2.Condition{
+				{
+					Type:    collection.Ptr(state.String()),
+					Status:  collection.Ptr("True"),
+					Reason:  collection.Ptr("CruiseControlError"),
+					Message: collection.Ptr("something went wrong"),
+				},
+			},
+		}
+		return result
+	}
+
+	Context("Request", func() {
+		JustBeforeEach(func() {
+			err = rebalanceManager.Request(ctx, rebalance)
+		})
+		Context("rebalance does not exist", func() {
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("creates the rebalance", func() {
+				result, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Get(ctx, "my-rebalance", metav1.GetOptions{})
+				Expect(err).To(BeNil())
+				Expect(*result.Spec.Mode).To(Equal(v1beta2.KafkaRebalanceModeFull))
+				Expect(result.Annotations).NotTo(HaveKey(v1beta2.RebalanceAnnotation))
+			})
+		})
+		Context("rebalance exists", func() {
+			BeforeEach(func() {
+				existing := rebalance.DeepCopy()
+				existing.ResourceVersion = "42"
+				existing.Spec.Mode = collection.Ptr(v1beta2.KafkaRebalanceModeAddBrokers)
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("updates the spec and requests a refresh", func() {
+				result, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Get(ctx, "my-rebalance", metav1.GetOptions{})
+				Expect(err).To(BeNil())
+				Expect(*result.Spec.Mode).To(Equal(v1beta2.KafkaRebalanceModeFull))
+				Expect(result.Annotations).To(
+					HaveKeyWithValue(v1beta2.RebalanceAnnotation, v1beta2.RebalanceActionRefresh),
+				)
+			})
+		})
+	})
+
+	DescribeTable("annotates the rebalance",
+		func(action func(ctx context.Context) error, expected string) {
+			_, err := clientset.KafkaV1beta2().
+				KafkaRebalances("kafka").
+				Create(ctx, &rebalance, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+
+			Expect(action(ctx)).To(Succeed())
+
+			result, err := clientset.KafkaV1beta2().
+				KafkaRebalances("kafka").
+				Get(ctx, "my-rebalance", metav1.GetOptions{})
+			Expect(err).To(BeNil())
+			Expect(result.Annotations).To(HaveKeyWithValue(v1beta2.RebalanceAnnotation, expected))
+			Expect(result.Labels).To(HaveKeyWithValue("strimzi.io/cluster", "my-cluster"))
+		},
+		Entry("approve", func(ctx context.Context) error {
+			return rebalanceManager.Approve(ctx, "kafka", "my-rebalance")
+		}, v1beta2.RebalanceActionApprove),
+		Entry("refresh", func(ctx context.Context) error {
+			return rebalanceManager.Refresh(ctx, "kafka", "my-rebalance")
+		}, v1beta2.RebalanceActionRefresh),
+		Entry("stop", func(ctx context.Context) error {
+			return rebalanceManager.Stop(ctx, "kafka", "my-rebalance")
+		}, v1beta2.RebalanceActionStop),
+	)
+
+	It("returns an error when annotating a missing rebalance", func() {
+		Expect(rebalanceManager.Approve(ctx, "kafka", "my-rebalance")).NotTo(Succeed())
+	})
+
+	Context("WaitForProposal", func() {
+		var result *v1beta2.KafkaRebalance
+		var timeout time.Duration
+		BeforeEach(func() {
+			timeout = time.Second
+		})
+		JustBeforeEach(func() {
+			result, err = rebalanceManager.WaitForProposal(ctx, "kafka", "my-rebalance", timeout)
+		})
+		Context("proposal is ready", func() {
+			BeforeEach(func() {
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, withState(v1beta2.KafkaRebalanceStateProposalReady), metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("returns the rebalance", func() {
+				Expect(result).NotTo(BeNil())
+				Expect(result.State()).To(Equal(v1beta2.KafkaRebalanceStateProposalReady))
+			})
+		})
+		Context("rebalance is not ready", func() {
+			BeforeEach(func() {
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, withState(v1beta2.KafkaRebalanceStateNotReady), metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns an error with the reason", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("NotReady"))
+				Expect(err.Error()).To(ContainSubstring("CruiseControlError"))
+				Expect(err.Error()).To(ContainSubstring("something went wrong"))
+			})
+		})
+		Context("proposal is ready but the action is pending", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				existing := withState(v1beta2.KafkaRebalanceStateProposalReady)
+				existing.Annotations = map[string]string{
+					v1beta2.RebalanceAnnotation: v1beta2.RebalanceActionRefresh,
+				}
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns an error after the timeout", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
+		Context("rebalance is stopped but the action is pending", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				existing := withState(v1beta2.KafkaRebalanceStateStopped)
+				existing.Annotations = map[string]string{
+					v1beta2.RebalanceAnnotation: v1beta2.RebalanceActionRefresh,
+				}
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("waits instead of returning the stopped state", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).NotTo(ContainSubstring("is Stopped"))
+			})
+		})
+		Context("rebalance is ready", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, withState(v1beta2.KafkaRebalanceStateReady), metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns an error after the timeout", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
+		Context("proposal is pending", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				_, err := clientset.KafkaV1beta2().
+					KafkaRebalances("kafka").
+					Create(ctx, withState(v1beta2.KafkaRebalanceStatePendingProposal), metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns an error after the timeout", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(result).To(BeNil())
+			})
+		})
+	})
+
+	DescribeTable("waits for a new proposal after Request on an existing rebalance",
+		func(state v1beta2.KafkaRebalanceState) {
+			_, err := clientset.KafkaV1beta2().
+				KafkaRebalances("kafka").
+				Create(ctx, withState(state), metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+			Expect(rebalanceManager.Request(ctx, rebalance)).To(Succeed())
+
+			_, err = rebalanceManager.WaitForProposal(
+				ctx,
+				"kafka",
+				"my-rebalance",
+				50*time.Millisecond,
+			)
+			Expect(err).NotTo(BeNil())
+
+			done := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				_, err := rebalanceManager.WaitForProposal(
+					ctx,
+					"kafka",
+					"my-rebalance",
+					5*time.Second,
+				)
+				done <- err
+			}()
+			Eventually(func() int {
+				var counter int
+				for _, action := range clientset.Actions() {
+					if action.GetVerb() == "watch" {
+						counter++
+					}
+				}
+				return counter
+			}).Should(Equal(2))
+
+			// the operator handled the refresh and removed the annotation
+			_, err = clientset.KafkaV1beta2().
+				KafkaRebalances("kafka").
+				Update(ctx, withState(v1beta2.KafkaRebalanceStateProposalReady), metav1.UpdateOptions{})
+			Expect(err).To(BeNil())
+			Eventually(done).Should(Receive(BeNil()))
+		},
+		Entry("proposal ready", v1beta2.KafkaRebalanceStateProposalReady),
+		Entry("ready", v1beta2.KafkaRebalanceStateReady),
+	)
+
+	It("waits until the proposal becomes ready", func() {
+		_, err := clientset.KafkaV1beta2().
+			KafkaRebalances("kafka").
+			Create(ctx, withState(v1beta2.KafkaRebalanceStatePendingProposal), metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+
+		done := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			_, err := rebalanceManager.WaitForProposal(ctx, "kafka", "my-rebalance", 5*time.Second)
+			done <- err
+		}()
+		Eventually(func() bool {
+			for _, action := range clientset.Actions() {
+				if action.GetVerb() == "watch" {
+					return true
+				}
+			}
+			return false
+		}).Should(BeTrue())
+
+		_, err = clientset.KafkaV1beta2().
+			KafkaRebalances("kafka").
+			Update(ctx, withState(v1beta2.KafkaRebalanceStateProposalReady), metav1.UpdateOptions{})
+		Expect(err).To(BeNil())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
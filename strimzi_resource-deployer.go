@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// resourceClient is the part of a generated typed client used by deployResource and
+// undeployResource, e.g. KafkaV1beta2().KafkaUsers(namespace).
+type resourceClient[T metav1.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// resourceKind describes a resource for deployResource and undeployResource.
+type resourceKind struct {
+	// name is used in log and error messages, e.g. "user".
+	name string
+	// markError marks API errors with the sentinel errors of the resource.
+	markError func(err error) error
+}
+
+// deployResource creates the resource if it is not found and otherwise updates it
+// with the resource version of the current one. All other errors are returned.
+func deployResource[T metav1.Object](
+	ctx context.Context,
+	client resourceClient[T],
+	kind resourceKind,
+	obj T,
+) error {
+	name := obj.GetName()
+	current, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(ctx, kind.markError(err), "get %s %s failed", kind.name, name)
+		}
+		glog.V(3).Infof("%s %s not found => create", kind.name, name)
+		if _, err := client.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return errors.Wrapf(ctx, kind.markError(err), "create %s %s failed", kind.name, name)
+		}
+		glog.V(3).Infof("%s %s created successful", kind.name, name)
+		return nil
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	if _, err := client.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(ctx, kind.markError(err), "update %s %s failed", kind.name, name)
+	}
+	glog.V(3).Infof("%s %s updated successful", kind.name, name)
+	return nil
+}
+
+// undeployResource deletes the resource. A resource that is not found is skipped, all
+// other errors are returned.
+func undeployResource[T metav1.Object](
+	ctx context.Context,
+	client resourceClient[T],
+	kind resourceKind,
+	name string,
+) error {
+	if _, err := client.Get(ctx, name, metav1.GetOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(ctx, kind.markError(err), "get %s %s failed", kind.name, name)
+		}
+		glog.V(3).Infof("%s '%s' not found => skip", kind.name, name)
+		return nil
+	}
+	if err := client.Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			glog.V(3).Infof("%s '%s' already deleted", kind.name, name)
+			return nil
+		}
+		return errors.Wrapf(ctx, kind.markError(err), "delete %s %s failed", kind.name, name)
+	}
+	glog.V(3).Infof("delete %s completed", name)
+	return nil
+}
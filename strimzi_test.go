@@ -15,7 +15,7 @@ import (
 
 const topicJSON = `
 {
-  "apiVersion": "kafka.strimzi.io/v1beta2beta2",
+  "apiVersion": "kafka.strimzi.io/v1beta2",
   "kind": "KafkaTopic",
   "metadata": {
     "name": "my-topic"
@@ -43,6 +43,10 @@ var _ = Describe("KafkaTopic", func() {
 	It("returns no error", func() {
 		Expect(err).To(BeNil())
 	})
+	It("contains apiVersion and kind", func() {
+		Expect(topic.APIVersion).To(Equal(v1beta2.SchemeGroupVersion.String()))
+		Expect(topic.Kind).To(Equal("KafkaTopic"))
+	})
 	It("contains config values", func() {
 		Expect(topic.Spec.Config).To(HaveKeyWithValue("retention.ms", "-1"))
 		Expect(topic.Spec.Config).To(HaveKeyWithValue("retention.bytes", "-1"))
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applykafkav1 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1"
+	applyv1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+)
+
+// NewKafkaTopicApplyConfiguration builds the server-side apply configuration for the
+// given topic. Only the fields the caller sets are included: name, namespace, labels,
+// annotations and spec. Status and server-managed metadata like resourceVersion are
+// never applied, so fields owned by other managers stay untouched.
+func NewKafkaTopicApplyConfiguration(
+	topic v1beta2.KafkaTopic,
+) *applyv1beta2.KafkaTopicApplyConfiguration {
+	result := applyv1beta2.KafkaTopic(topic.Name, topic.Namespace)
+	if len(topic.Labels) > 0 {
+		result.WithLabels(topic.Labels)
+	}
+	if len(topic.Annotations) > 0 {
+		result.WithAnnotations(topic.Annotations)
+	}
+	if topic.Spec != nil {
+		spec := applyv1beta2.KafkaTopicSpec()
+		if len(topic.Spec.Config) > 0 {
+			spec.WithConfig(topic.Spec.Config)
+		}
+		if topic.Spec.Partitions != nil {
+			spec.WithPartitions(*topic.Spec.Partitions)
+		}
+		if topic.Spec.Replicas != nil {
+			spec.WithReplicas(*topic.Spec.Replicas)
+		}
+		if topic.Spec.TopicName != nil {
+			spec.WithTopicName(*topic.Spec.TopicName)
+		}
+		result.WithSpec(spec)
+	}
+	return result
+}
+
+func newKafkaTopicApplyConfigurationV1(
+	topic kafkav1.KafkaTopic,
+) *applykafkav1.KafkaTopicApplyConfiguration {
+	result := applykafkav1.KafkaTopic(topic.Name, topic.Namespace)
+	if len(topic.Labels) > 0 {
+		result.WithLabels(topic.Labels)
+	}
+	if len(topic.Annotations) > 0 {
+		result.WithAnnotations(topic.Annotations)
+	}
+	if topic.Spec != nil {
+		spec := applykafkav1.KafkaTopicSpec()
+		if len(topic.Spec.Config) > 0 {
+			spec.WithConfig(topic.Spec.Config)
+		}
+		if topic.Spec.Partitions != nil {
+			spec.WithPartitions(*topic.Spec.Partitions)
+		}
+		if topic.Spec.Replicas != nil {
+			spec.WithReplicas(*topic.Spec.Replicas)
+		}
+		if topic.Spec.TopicName != nil {
+			spec.WithTopicName(*topic.Spec.TopicName)
+		}
+		result.WithSpec(spec)
+	}
+	return result
+}
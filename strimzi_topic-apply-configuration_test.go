@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("NewKafkaTopicApplyConfiguration", func() {
+	It("contains identity, labels, annotations and spec", func() {
+		result := strimzi.NewKafkaTopicApplyConfiguration(v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "my-topic",
+				Namespace:       "kafka",
+				ResourceVersion: "42",
+				Labels:          map[string]string{"strimzi.io/cluster": "my-cluster"},
+				Annotations:     map[string]string{"foo": "bar"},
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+				Replicas:   collection.Ptr(int32(2)),
+				TopicName:  collection.Ptr("my.topic"),
+				Config:     map[string]string{"retention.ms": "1000"},
+			},
+			Status: &v1beta2.KafkaTopicStatus{
+				TopicName: collection.Ptr("my.topic"),
+			},
+		})
+		Expect(*result.GetName()).To(Equal("my-topic"))
+		Expect(*result.GetNamespace()).To(Equal("kafka"))
+		Expect(*result.GetAPIVersion()).To(Equal("kafka.strimzi.io/v1beta2"))
+		Expect(*result.GetKind()).To(Equal("KafkaTopic"))
+		Expect(result.ResourceVersion).To(BeNil())
+		Expect(result.Labels).To(HaveKeyWithValue("strimzi.io/cluster", "my-cluster"))
+		Expect(result.Annotations).To(HaveKeyWithValue("foo", "bar"))
+		Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+		Expect(*result.Spec.Replicas).To(Equal(int32(2)))
+		Expect(*result.Spec.TopicName).To(Equal("my.topic"))
+		Expect(result.Spec.Config).To(HaveKeyWithValue("retention.ms", "1000"))
+		Expect(result.Status).To(BeNil())
+	})
+	It("omits unset fields", func() {
+		result := strimzi.NewKafkaTopicApplyConfiguration(v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "kafka"},
+			Spec:       &v1beta2.KafkaTopicSpec{},
+		})
+		Expect(result.Labels).To(BeNil())
+		Expect(result.Annotations).To(BeNil())
+		Expect(result.Spec.Partitions).To(BeNil())
+		Expect(result.Spec.Config).To(BeNil())
+	})
+})
@@ -0,0 +1,218 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// TopicBuilder builds a v1beta2.KafkaTopic without allocating pointers by hand.
+// Partitions and replicas that are not set are defaulted by the broker.
+//
+//	topic, err := strimzi.NewTopic("kafka", "my-topic").
+//		Cluster("my-cluster").
+//		Partitions(12).
+//		Replicas(3).
+//		Compacted().
+//		Build(ctx)
+type TopicBuilder struct {
+	namespace   string
+	name        string
+	topicName   string
+	partitions  *int32
+	replicas    *int32
+	config      map[string]string
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// NewTopic starts building the KafkaTopic with the given namespace and name.
+func NewTopic(namespace string, name string) *TopicBuilder {
+	return &TopicBuilder{
+		namespace:   namespace,
+		name:        name,
+		config:      map[string]string{},
+		labels:      map[string]string{},
+		annotations: map[string]string{},
+	}
+}
+
+// Cluster sets the v1beta2.ClusterLabel, so the topic operator of the cluster picks up
+// the topic. It is required.
+func (t *TopicBuilder) Cluster(cluster string) *TopicBuilder {
+	return t.Label(v1beta2.ClusterLabel, cluster)
+}
+
+// TopicName sets the Kafka topic name when it differs from the resource name.
+func (t *TopicBuilder) TopicName(topicName string) *TopicBuilder {
+	t.topicName = topicName
+	return t
+}
+
+// Partitions sets the number of partitions.
+func (t *TopicBuilder) Partitions(partitions int32) *TopicBuilder {
+	t.partitions = &partitions
+	return t
+}
+
+// Replicas sets the replication factor.
+func (t *TopicBuilder) Replicas(replicas int32) *TopicBuilder {
+	t.replicas = &replicas
+	return t
+}
+
+// Compacted sets cleanup.policy to compact, so Kafka keeps the latest record per key.
+func (t *TopicBuilder) Compacted() *TopicBuilder {
+	return t.Config("cleanup.policy", string(v1beta2.CleanupPolicyCompact))
+}
+
+// Retention sets retention.ms to the given duration.
+func (t *TopicBuilder) Retention(retention time.Duration) *TopicBuilder {
+	return t.Config("retention.ms", strconv.FormatInt(retention.Milliseconds(), 10))
+}
+
+// InfiniteRetention sets retention.ms to -1, so Kafka never deletes records by age.
+func (t *TopicBuilder) InfiniteRetention() *TopicBuilder {
+	return t.Config("retention.ms", "-1")
+}
+
+// RetentionBytes sets retention.bytes, the maximum size of a partition before old
+// segments are deleted.
+func (t *TopicBuilder) RetentionBytes(retentionBytes int64) *TopicBuilder {
+	return t.Config("retention.bytes", strconv.FormatInt(retentionBytes, 10))
+}
+
+// Config sets a single topic config value.
+func (t *TopicBuilder) Config(key string, value string) *TopicBuilder {
+	t.config[key] = value
+	return t
+}
+
+// TopicConfig sets all values of the typed topic config.
+func (t *TopicBuilder) TopicConfig(topicConfig v1beta2.TopicConfig) *TopicBuilder {
+	maps.Copy(t.config, topicConfig.Map())
+	return t
+}
+
+// Label sets a label, e.g. to select the topics of an application with a
+// TopicSetReconciler.
+func (t *TopicBuilder) Label(key string, value string) *TopicBuilder {
+	t.labels[key] = value
+	return t
+}
+
+// Annotation sets an annotation, e.g. the ProtectAnnotation.
+func (t *TopicBuilder) Annotation(key string, value string) *TopicBuilder {
+	t.annotations[key] = value
+	return t
+}
+
+// Validate checks the namespace, resource name, Kafka topic name, cluster, partitions,
+// replicas and topic config.
+func (t *TopicBuilder) Validate(ctx context.Context) error {
+	if t.namespace == "" {
+		return errors.Errorf(ctx, "namespace of topic %s missing", t.name)
+	}
+	if problems := validation.IsDNS1123Subdomain(t.name); len(problems) > 0 {
+		return errors.Errorf(
+			ctx,
+			"invalid topic name '%s': %s",
+			t.name,
+			strings.Join(problems, ", "),
+		)
+	}
+	kafkaTopicName := t.name
+	if t.topicName != "" {
+		kafkaTopicName = t.topicName
+	}
+	if problem := validateKafkaTopicName(kafkaTopicName); problem != "" {
+		return errors.Errorf(ctx, "invalid kafka topic name '%s': %s", kafkaTopicName, problem)
+	}
+	if t.labels[v1beta2.ClusterLabel] == "" {
+		return errors.Errorf(ctx, "cluster of topic %s missing", t.name)
+	}
+	if t.partitions != nil && *t.partitions < 1 {
+		return errors.Errorf(ctx, "partitions of topic %s must be at least 1", t.name)
+	}
+	if t.replicas != nil && *t.replicas < 1 {
+		return errors.Errorf(ctx, "replicas of topic %s must be at least 1", t.name)
+	}
+	if err := v1beta2.ValidateTopicConfig(ctx, t.config); err != nil {
+		return errors.Wrapf(ctx, err, "validate config of topic %s failed", t.name)
+	}
+	return nil
+}
+
+// maxKafkaTopicNameLength is the maximum length of a topic name Kafka accepts.
+const maxKafkaTopicNameLength = 249
+
+var kafkaTopicNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// validateKafkaTopicName returns why Kafka rejects the topic name or an empty string
+// if it is valid.
+func validateKafkaTopicName(name string) string {
+	switch {
+	case name == "." || name == "..":
+		return "must not be '.' or '..'"
+	case len(name) > maxKafkaTopicNameLength:
+		return fmt.Sprintf("must be at most %d characters", maxKafkaTopicNameLength)
+	case !kafkaTopicNameRegexp.MatchString(name):
+		return "must only contain ASCII alphanumerics, '.', '_' and '-'"
+	default:
+		return ""
+	}
+}
+
+// Build validates the topic and returns it with apiVersion and kind set.
+func (t *TopicBuilder) Build(ctx context.Context) (v1beta2.KafkaTopic, error) {
+	if err := t.Validate(ctx); err != nil {
+		return v1beta2.KafkaTopic{}, err
+	}
+	result := v1beta2.KafkaTopic{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta2.SchemeGroupVersion.String(),
+			Kind:       "KafkaTopic",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.namespace,
+			Name:      t.name,
+			Labels:    maps.Clone(t.labels),
+		},
+		Spec: &v1beta2.KafkaTopicSpec{
+			Partitions: copyPtr(t.partitions),
+			Replicas:   copyPtr(t.replicas),
+		},
+	}
+	if len(t.annotations) > 0 {
+		result.Annotations = maps.Clone(t.annotations)
+	}
+	if len(t.config) > 0 {
+		result.Spec.Config = maps.Clone(t.config)
+	}
+	if t.topicName != "" {
+		result.Spec.TopicName = copyPtr(&t.topicName)
+	}
+	return result, nil
+}
+
+func copyPtr[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	result := *value
+	return &result
+}
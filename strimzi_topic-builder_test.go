@@ -0,0 +1,175 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("TopicBuilder", func() {
+	var ctx context.Context
+	var topicBuilder *strimzi.TopicBuilder
+	var topic v1beta2.KafkaTopic
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		topicBuilder = strimzi.NewTopic("kafka", "my-topic").
+			Cluster("my-cluster").
+			Partitions(12).
+			Replicas(3).
+			Compacted().
+			Retention(7 * 24 * time.Hour)
+	})
+	JustBeforeEach(func() {
+		topic, err = topicBuilder.Build(ctx)
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("sets apiVersion and kind", func() {
+		Expect(topic.APIVersion).To(Equal("kafka.strimzi.io/v1beta2"))
+		Expect(topic.Kind).To(Equal("KafkaTopic"))
+	})
+	It("sets namespace, name and cluster label", func() {
+		Expect(topic.Namespace).To(Equal("kafka"))
+		Expect(topic.Name).To(Equal("my-topic"))
+		Expect(topic.Labels).To(Equal(map[string]string{v1beta2.ClusterLabel: "my-cluster"}))
+		Expect(topic.Annotations).To(BeNil())
+	})
+	It("sets the spec", func() {
+		Expect(topic.Spec).To(Equal(&v1beta2.KafkaTopicSpec{
+			Partitions: collection.Ptr(int32(12)),
+			Replicas:   collection.Ptr(int32(3)),
+			Config: map[string]string{
+				"cleanup.policy": "compact",
+				"retention.ms":   "604800000",
+			},
+		}))
+	})
+	It("marshals to a KafkaTopic manifest", func() {
+		content, err := json.Marshal(topic)
+		Expect(err).To(BeNil())
+		Expect(content).To(MatchJSON(`{
+			"apiVersion": "kafka.strimzi.io/v1beta2",
+			"kind": "KafkaTopic",
+			"metadata": {
+				"name": "my-topic",
+				"namespace": "kafka",
+				"labels": {"strimzi.io/cluster": "my-cluster"}
+			},
+			"spec": {
+				"partitions": 12,
+				"replicas": 3,
+				"config": {"cleanup.policy": "compact", "retention.ms": "604800000"}
+			}
+		}`))
+	})
+	It("does not share state with the builder", func() {
+		topicBuilder.Label("app", "my-app").Partitions(24)
+		Expect(topic.Labels).NotTo(HaveKey("app"))
+		Expect(*topic.Spec.Partitions).To(Equal(int32(12)))
+	})
+
+	Context("with more settings", func() {
+		BeforeEach(func() {
+			topicBuilder.
+				TopicName("My.Topic").
+				InfiniteRetention().
+				RetentionBytes(1024).
+				Label("app", "my-app").
+				Annotation(strimzi.ProtectAnnotation, "true").
+				TopicConfig(v1beta2.TopicConfig{MinInsyncReplicas: collection.Ptr(int32(2))})
+		})
+		It("sets them", func() {
+			Expect(err).To(BeNil())
+			Expect(topic.TopicName()).To(Equal("My.Topic"))
+			Expect(topic.Labels).To(HaveKeyWithValue("app", "my-app"))
+			Expect(topic.Annotations).To(HaveKeyWithValue(strimzi.ProtectAnnotation, "true"))
+			Expect(topic.Spec.Config).To(Equal(map[string]string{
+				"cleanup.policy":      "compact",
+				"min.insync.replicas": "2",
+				"retention.bytes":     "1024",
+				"retention.ms":        "-1",
+			}))
+		})
+	})
+
+	Context("without partitions and replicas", func() {
+		BeforeEach(func() {
+			topicBuilder = strimzi.NewTopic("kafka", "my-topic").Cluster("my-cluster")
+		})
+		It("leaves them to the broker", func() {
+			Expect(err).To(BeNil())
+			Expect(topic.Spec.Partitions).To(BeNil())
+			Expect(topic.Spec.Replicas).To(BeNil())
+			Expect(topic.Spec.Config).To(BeNil())
+		})
+	})
+
+	DescribeTable("invalid topics",
+		func(topicBuilder *strimzi.TopicBuilder, expectedError string) {
+			_, err := topicBuilder.Build(context.Background())
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring(expectedError))
+		},
+		Entry("without namespace",
+			strimzi.NewTopic("", "my-topic").Cluster("my-cluster"),
+			"namespace of topic my-topic missing",
+		),
+		Entry("with invalid name",
+			strimzi.NewTopic("kafka", "My_Topic").Cluster("my-cluster"),
+			"invalid topic name 'My_Topic'",
+		),
+		Entry("with invalid topic name",
+			strimzi.NewTopic("kafka", "my-topic").Cluster("my-cluster").TopicName("my topic"),
+			"invalid kafka topic name 'my topic'",
+		),
+		Entry("with topic name dot",
+			strimzi.NewTopic("kafka", "my-topic").Cluster("my-cluster").TopicName(".."),
+			"invalid kafka topic name '..'",
+		),
+		Entry("with too long topic name",
+			strimzi.NewTopic("kafka", "my-topic").
+				Cluster("my-cluster").
+				TopicName(strings.Repeat("a", 250)),
+			"must be at most 249 characters",
+		),
+		Entry("with too long name",
+			strimzi.NewTopic("kafka", strings.Repeat("a", 250)).Cluster("my-cluster"),
+			"must be at most 249 characters",
+		),
+		Entry("without cluster",
+			strimzi.NewTopic("kafka", "my-topic"),
+			"cluster of topic my-topic missing",
+		),
+		Entry("with zero partitions",
+			strimzi.NewTopic("kafka", "my-topic").Cluster("my-cluster").Partitions(0),
+			"partitions of topic my-topic must be at least 1",
+		),
+		Entry("with zero replicas",
+			strimzi.NewTopic("kafka", "my-topic").Cluster("my-cluster").Replicas(0),
+			"replicas of topic my-topic must be at least 1",
+		),
+		Entry(
+			"with invalid config",
+			strimzi.NewTopic("kafka", "my-topic").
+				Cluster("my-cluster").
+				Config("retention.msec", "1"),
+			"unknown key retention.msec",
+		),
+	)
+})
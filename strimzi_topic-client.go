@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+// APIVersion is a version of the kafka.strimzi.io API group.
+type APIVersion string
+
+const (
+	// APIVersionV1beta2 targets kafka.strimzi.io/v1beta2.
+	APIVersionV1beta2 APIVersion = "v1beta2"
+	// APIVersionV1 targets kafka.strimzi.io/v1.
+	APIVersionV1 APIVersion = "v1"
+)
+
+// String returns the version as string.
+func (a APIVersion) String() string {
+	return string(a)
+}
+
+// topicClient reads and writes KafkaTopics in one API version while always
+// exposing them as v1beta2.KafkaTopic to the caller.
+type topicClient interface {
+	Get(ctx context.Context, namespace string, name string) (*v1beta2.KafkaTopic, error)
+	Create(
+		ctx context.Context,
+		topic *v1beta2.KafkaTopic,
+		opts metav1.CreateOptions,
+	) (*v1beta2.KafkaTopic, error)
+	Update(
+		ctx context.Context,
+		topic *v1beta2.KafkaTopic,
+		opts metav1.UpdateOptions,
+	) (*v1beta2.KafkaTopic, error)
+	Delete(ctx context.Context, namespace string, name string, opts metav1.DeleteOptions) error
+	List(ctx context.Context, namespace string, selector string) (v1beta2.KafkaTopics, error)
+	Apply(
+		ctx context.Context,
+		topic *v1beta2.KafkaTopic,
+		opts metav1.ApplyOptions,
+	) (*v1beta2.KafkaTopic, error)
+	// ListWatch returns a ListerWatcher for the topic with the given name and the type
+	// of the objects it returns. Use asV1beta2Topic to convert them.
+	ListWatch(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (cache.ListerWatcher, runtime.Object, error)
+}
+
+func newTopicClient(clientset versioned.Interface, apiVersion APIVersion) topicClient {
+	switch apiVersion {
+	case APIVersionV1:
+		return &topicClientV1{clientset: clientset}
+	case APIVersionV1beta2, "":
+		return &topicClientV1beta2{clientset: clientset}
+	default:
+		return &topicClientUnsupported{apiVersion: apiVersion}
+	}
+}
+
+type topicClientV1beta2 struct {
+	clientset versioned.Interface
+}
+
+func (t *topicClientV1beta2) Get(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*v1beta2.KafkaTopic, error) {
+	return t.clientset.KafkaV1beta2().KafkaTopics(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (t *topicClientV1beta2) Create(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.CreateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return t.clientset.KafkaV1beta2().
+		KafkaTopics(topic.Namespace).
+		Create(ctx, topic, opts)
+}
+
+func (t *topicClientV1beta2) Update(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.UpdateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return t.clientset.KafkaV1beta2().
+		KafkaTopics(topic.Namespace).
+		Update(ctx, topic, opts)
+}
+
+func (t *topicClientV1beta2) Delete(
+	ctx context.Context,
+	namespace string,
+	name string,
+	opts metav1.DeleteOptions,
+) error {
+	return t.clientset.KafkaV1beta2().
+		KafkaTopics(namespace).
+		Delete(ctx, name, opts)
+}
+
+func (t *topicClientV1beta2) List(
+	ctx context.Context,
+	namespace string,
+	selector string,
+) (v1beta2.KafkaTopics, error) {
+	result, err := t.clientset.KafkaV1beta2().
+		KafkaTopics(namespace).
+		List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func (t *topicClientV1beta2) Apply(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.ApplyOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return t.clientset.KafkaV1beta2().
+		KafkaTopics(topic.Namespace).
+		Apply(ctx, NewKafkaTopicApplyConfiguration(*topic), opts)
+}
+
+func (t *topicClientV1beta2) ListWatch(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (cache.ListerWatcher, runtime.Object, error) {
+	client := t.clientset.KafkaV1beta2().KafkaTopics(namespace)
+	return newNameListWatch(
+		t.clientset,
+		name,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return client.List(ctx, opts)
+		},
+		client.Watch,
+	), &v1beta2.KafkaTopic{}, nil
+}
+
+type topicClientV1 struct {
+	clientset versioned.Interface
+}
+
+func (t *topicClientV1) Get(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*v1beta2.KafkaTopic, error) {
+	result, err := t.clientset.KafkaV1().KafkaTopics(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return toV1beta2Topic(result), nil
+}
+
+func (t *topicClientV1) Create(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.CreateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	v1Topic := kafkav1.KafkaTopicFromV1beta2(*topic)
+	result, err := t.clientset.KafkaV1().
+		KafkaTopics(topic.Namespace).
+		Create(ctx, &v1Topic, opts)
+	if err != nil {
+		return nil, err
+	}
+	return toV1beta2Topic(result), nil
+}
+
+func (t *topicClientV1) Update(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.UpdateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	v1Topic := kafkav1.KafkaTopicFromV1beta2(*topic)
+	result, err := t.clientset.KafkaV1().
+		KafkaTopics(topic.Namespace).
+		Update(ctx, &v1Topic, opts)
+	if err != nil {
+		return nil, err
+	}
+	return toV1beta2Topic(result), nil
+}
+
+func (t *topicClientV1) Delete(
+	ctx context.Context,
+	namespace string,
+	name string,
+	opts metav1.DeleteOptions,
+) error {
+	return t.clientset.KafkaV1().KafkaTopics(namespace).Delete(ctx, name, opts)
+}
+
+func (t *topicClientV1) List(
+	ctx context.Context,
+	namespace string,
+	selector string,
+) (v1beta2.KafkaTopics, error) {
+	result, err := t.clientset.KafkaV1().
+		KafkaTopics(namespace).
+		List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return kafkav1.KafkaTopicsToV1beta2(result.Items), nil
+}
+
+func (t *topicClientV1) Apply(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.ApplyOptions,
+) (*v1beta2.KafkaTopic, error) {
+	result, err := t.clientset.KafkaV1().
+		KafkaTopics(topic.Namespace).
+		Apply(ctx, newKafkaTopicApplyConfigurationV1(kafkav1.KafkaTopicFromV1beta2(*topic)), opts)
+	if err != nil {
+		return nil, err
+	}
+	return toV1beta2Topic(result), nil
+}
+
+func (t *topicClientV1) ListWatch(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (cache.ListerWatcher, runtime.Object, error) {
+	client := t.clientset.KafkaV1().KafkaTopics(namespace)
+	return newNameListWatch(
+		t.clientset,
+		name,
+		func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			return client.List(ctx, opts)
+		},
+		client.Watch,
+	), &kafkav1.KafkaTopic{}, nil
+}
+
+func toV1beta2Topic(topic *kafkav1.KafkaTopic) *v1beta2.KafkaTopic {
+	result := kafkav1.KafkaTopicToV1beta2(*topic)
+	return &result
+}
+
+// asV1beta2Topic converts a KafkaTopic returned by a ListerWatcher of any supported
+// API version to v1beta2.
+func asV1beta2Topic(obj runtime.Object) (*v1beta2.KafkaTopic, bool) {
+	switch topic := obj.(type) {
+	case *v1beta2.KafkaTopic:
+		return topic, true
+	case *kafkav1.KafkaTopic:
+		return toV1beta2Topic(topic), true
+	default:
+		return nil, false
+	}
+}
+
+type topicClientUnsupported struct {
+	apiVersion APIVersion
+}
+
+func (t *topicClientUnsupported) Get(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*v1beta2.KafkaTopic, error) {
+	return nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) Create(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.CreateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) Update(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.UpdateOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) Delete(
+	ctx context.Context,
+	namespace string,
+	name string,
+	opts metav1.DeleteOptions,
+) error {
+	return t.err(ctx)
+}
+
+func (t *topicClientUnsupported) List(
+	ctx context.Context,
+	namespace string,
+	selector string,
+) (v1beta2.KafkaTopics, error) {
+	return nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) Apply(
+	ctx context.Context,
+	topic *v1beta2.KafkaTopic,
+	opts metav1.ApplyOptions,
+) (*v1beta2.KafkaTopic, error) {
+	return nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) ListWatch(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (cache.ListerWatcher, runtime.Object, error) {
+	return nil, nil, t.err(ctx)
+}
+
+func (t *topicClientUnsupported) err(ctx context.Context) error {
+	return errors.Errorf(ctx, "unsupported api version '%s'", t.apiVersion)
+}
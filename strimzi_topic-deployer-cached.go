@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+	"github.com/bborbe/strimzi/k8s/client/informers/externalversions"
+)
+
+// NewCachedTopicDeployer creates a TopicDeployer that reads the current topics from a
+// KafkaTopic informer instead of the API server. The informer watches the given
+// namespace, an empty namespace watches all namespaces. Topics missing in the cache,
+// e.g. topics of other namespaces or topics created a moment ago, are read from the
+// API server. Writes and Undeploy always go to the API server, and a deploy that
+// conflicted reads the topic from the API server before it retries.
+//
+// The informer is started and synced before the deployer is returned and runs until
+// ctx is canceled.
+//
+// Parameters:
+//   - ctx: Context that controls the lifetime of the informer
+//   - clientset: Strimzi clientset for interacting with KafkaTopic resources
+//   - namespace: Namespace watched by the informer
+//   - opts: Optional settings like for NewTopicDeployer
+//
+// Returns:
+//   - TopicDeployer: A new deployer instance reading from the informer cache
+//   - error: If the api version is not supported or the cache does not sync
+func NewCachedTopicDeployer(
+	ctx context.Context,
+	clientset versioned.Interface,
+	namespace string,
+	opts ...TopicDeployerOption,
+) (TopicDeployer, error) {
+	options := newTopicDeployerOptions(opts...)
+	factory := externalversions.NewSharedInformerFactoryWithOptions(
+		clientset,
+		0,
+		externalversions.WithNamespace(namespace),
+	)
+	getCached, err := newCachedTopicGetter(ctx, factory, options.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	factory.Start(ctx.Done())
+	for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, errors.Errorf(ctx, "sync cache of %v failed", informerType)
+		}
+	}
+	glog.V(2).Infof("topic cache of namespace '%s' synced", namespace)
+	liveTopicClient := newTopicClient(clientset, options.APIVersion)
+	return &topicDeployer{
+		topicClient: &cachedTopicClient{
+			topicClient: liveTopicClient,
+			namespace:   namespace,
+			getCached:   getCached,
+		},
+		liveTopicClient: liveTopicClient,
+		clusterClient:   newClusterClient(clientset, options.APIVersion),
+		options:         options,
+	}, nil
+}
+
+// cachedTopicGetter returns the topic from the informer cache.
+type cachedTopicGetter func(namespace string, name string) (*v1beta2.KafkaTopic, error)
+
+// newCachedTopicGetter registers the KafkaTopic informer of the api version with the
+// factory. It must be called before the factory is started.
+func newCachedTopicGetter(
+	ctx context.Context,
+	factory externalversions.SharedInformerFactory,
+	apiVersion APIVersion,
+) (cachedTopicGetter, error) {
+	switch apiVersion {
+	case APIVersionV1:
+		lister := factory.Kafka().V1().KafkaTopics().Lister()
+		return func(namespace string, name string) (*v1beta2.KafkaTopic, error) {
+			topic, err := lister.KafkaTopics(namespace).Get(name)
+			if err != nil {
+				return nil, err
+			}
+			result := kafkav1.KafkaTopicToV1beta2(*topic)
+			return &result, nil
+		}, nil
+	case APIVersionV1beta2, "":
+		lister := factory.Kafka().V1beta2().KafkaTopics().Lister()
+		return func(namespace string, name string) (*v1beta2.KafkaTopic, error) {
+			topic, err := lister.KafkaTopics(namespace).Get(name)
+			if err != nil {
+				return nil, err
+			}
+			// objects of the cache are shared and must not be modified
+			return topic.DeepCopy(), nil
+		}, nil
+	default:
+		return nil, errors.Errorf(ctx, "unsupported api version '%s'", apiVersion)
+	}
+}
+
+// cachedTopicClient serves Get from the informer cache and everything else from the
+// embedded topicClient.
+type cachedTopicClient struct {
+	topicClient
+	namespace string
+	getCached cachedTopicGetter
+}
+
+func (c *cachedTopicClient) Get(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*v1beta2.KafkaTopic, error) {
+	if c.namespace == "" || c.namespace == namespace {
+		topic, err := c.getCached(namespace, name)
+		if err == nil {
+			return topic, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		glog.V(3).Infof("topic %s/%s not in cache => read from api server", namespace, name)
+	}
+	return c.topicClient.Get(ctx, namespace, name)
+}
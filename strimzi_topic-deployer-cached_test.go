@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/bborbe/strimzi"
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("CachedTopicDeployer", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clientset *fake.Clientset
+	var opts []strimzi.TopicDeployerOption
+	var topicDeployer strimzi.TopicDeployer
+	var topic v1beta2.KafkaTopic
+	var err error
+
+	countActions := func(verb string) int {
+		var counter int
+		for _, action := range clientset.Actions() {
+			if action.GetVerb() == verb && action.GetResource().Resource == "kafkatopics" {
+				counter++
+			}
+		}
+		return counter
+	}
+	newTopic := func(namespace string, name string) v1beta2.KafkaTopic {
+		return v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(func() {
+			cancel()
+		})
+		clientset = fake.NewSimpleClientset()
+		opts = nil
+		topic = newTopic("kafka", "my-topic")
+		_, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			Create(ctx, &topic, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+	})
+	JustBeforeEach(func() {
+		topicDeployer, err = strimzi.NewCachedTopicDeployer(ctx, clientset, "kafka", opts...)
+		Expect(err).To(BeNil())
+		clientset.ClearActions()
+	})
+
+	It("reads an existing topic from the cache", func() {
+		result, err := topicDeployer.DeployWithResult(ctx, topic)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(strimzi.DeployActionUnchanged))
+		Expect(countActions("get")).To(Equal(0))
+	})
+
+	It("updates a changed topic", func() {
+		topic.Spec.Partitions = collection.Ptr(int32(6))
+		result, err := topicDeployer.DeployWithResult(ctx, topic)
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(strimzi.DeployActionUpdated))
+		Expect(countActions("get")).To(Equal(0))
+		Expect(countActions("update")).To(Equal(1))
+	})
+
+	It("reads a topic missing in the cache from the api server", func() {
+		result, err := topicDeployer.DeployWithResult(ctx, newTopic("kafka", "new-topic"))
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(strimzi.DeployActionCreated))
+		Expect(countActions("get")).To(Equal(1))
+	})
+
+	It("reads topics of other namespaces from the api server", func() {
+		result, err := topicDeployer.DeployWithResult(ctx, newTopic("other", "my-topic"))
+		Expect(err).To(BeNil())
+		Expect(result.Action).To(Equal(strimzi.DeployActionCreated))
+		Expect(countActions("get")).To(Equal(1))
+	})
+
+	It("reads the topic from the api server after a conflict", func() {
+		var conflicts int
+		clientset.PrependReactor(
+			"update",
+			"kafkatopics",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if conflicts > 0 {
+					return false, nil, nil
+				}
+				conflicts++
+				return true, nil, apierrors.NewConflict(
+					v1beta2.Resource("kafkatopics"),
+					"my-topic",
+					nil,
+				)
+			},
+		)
+		topic.Spec.Partitions = collection.Ptr(int32(6))
+		result, err := topicDeployer.DeployWithResult(ctx, topic)
+		Expect(err).To(BeNil())
+		Expect(result.Retries).To(Equal(1))
+		Expect(countActions("get")).To(Equal(1))
+		Expect(countActions("update")).To(Equal(2))
+	})
+
+	It("undeploys a topic read from the api server", func() {
+		Expect(topicDeployer.Undeploy(ctx, "kafka", "my-topic")).To(BeNil())
+		Expect(countActions("get")).To(Equal(1))
+		Expect(countActions("delete")).To(Equal(1))
+	})
+
+	It("refuses to undeploy a topic protected after the cache was filled", func() {
+		// the api server returns the protected topic, the cache still has the old one
+		clientset.PrependReactor(
+			"get",
+			"kafkatopics",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				protectedTopic := topic.DeepCopy()
+				protectedTopic.Annotations = map[string]string{strimzi.ProtectAnnotation: "true"}
+				return true, protectedTopic, nil
+			},
+		)
+		err := topicDeployer.Undeploy(ctx, "kafka", "my-topic")
+		Expect(stderrors.Is(err, strimzi.ErrTopicProtected)).To(BeTrue())
+		Expect(countActions("delete")).To(Equal(0))
+	})
+
+	Context("v1", func() {
+		BeforeEach(func() {
+			opts = append(opts, strimzi.WithAPIVersion(strimzi.APIVersionV1))
+			v1Topic := kafkav1.KafkaTopicFromV1beta2(topic)
+			_, err := clientset.KafkaV1().
+				KafkaTopics("kafka").
+				Create(ctx, &v1Topic, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		})
+		It("reads the topic from the v1 cache", func() {
+			result, err := topicDeployer.DeployWithResult(ctx, topic)
+			Expect(err).To(BeNil())
+			Expect(result.Action).To(Equal(strimzi.DeployActionUnchanged))
+			Expect(countActions("get")).To(Equal(0))
+		})
+	})
+
+	It("returns an error for an unsupported api version", func() {
+		_, err := strimzi.NewCachedTopicDeployer(
+			ctx,
+			clientset,
+			"kafka",
+			strimzi.WithAPIVersion("v2"),
+		)
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unsupported api version 'v2'"))
+	})
+
+	It("returns an error if the cache does not sync", func() {
+		canceledCtx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := strimzi.NewCachedTopicDeployer(canceledCtx, fake.NewSimpleClientset(), "kafka")
+		Expect(err).NotTo(BeNil())
+	})
+})
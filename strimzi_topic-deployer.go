@@ -6,10 +6,16 @@ package strimzi
 
 import (
 	"context"
+	"time"
 
 	"github.com/bborbe/errors"
 	"github.com/golang/glog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
 	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
@@ -22,73 +28,802 @@ import (
 type TopicDeployer interface {
 	// Deploy creates or updates a KafkaTopic resource in Kubernetes.
 	// If the topic doesn't exist, it will be created. If it exists, it will be updated
-	// with the new configuration while preserving the resource version.
+	// with the new configuration while preserving the resource version. The update is
+	// skipped if the spec, labels and annotations of the topic already match.
+	// Errors other than NotFound are returned; match them with ErrTopicForbidden
+	// and ErrTopicConflict. Decreasing partitions or changing replicas of an existing
+	// topic fails with ErrTopicPartitionDecrease or ErrTopicReplicasChange unless
+	// configured otherwise with WithPartitionDecreasePolicy or WithReplicasChangePolicy.
 	Deploy(ctx context.Context, topic v1beta2.KafkaTopic) error
 
+	// DeployWithResult works like Deploy and additionally reports details about the
+	// deployment, e.g. whether the topic was created, updated or left unchanged and
+	// the diff of the changes.
+	DeployWithResult(ctx context.Context, topic v1beta2.KafkaTopic) (DeployResult, error)
+
+	// DeployAndWait works like DeployWithResult and then waits up to timeout until the
+	// topic operator reports the topic Ready, see WaitForReady. With WithDryRun it
+	// does not wait.
+	DeployAndWait(
+		ctx context.Context,
+		topic v1beta2.KafkaTopic,
+		timeout time.Duration,
+	) (DeployResult, error)
+
+	// WaitForReady watches the topic until the topic operator has reconciled its current
+	// generation and reports the Ready condition as True. If the operator reports Ready
+	// as False, an error matching ErrTopicNotReady with its reason and message is returned.
+	WaitForReady(
+		ctx context.Context,
+		namespace string,
+		name string,
+		timeout time.Duration,
+	) (*v1beta2.KafkaTopic, error)
+
 	// Undeploy removes a KafkaTopic resource from Kubernetes.
-	// If the topic doesn't exist, the operation succeeds silently.
-	Undeploy(ctx context.Context, namespace string, name string) error
+	// If the topic doesn't exist, the operation succeeds silently. All other errors
+	// are returned. Topics with the ProtectAnnotation or matching a policy configured
+	// with WithProtectionPolicies are refused with ErrTopicProtected unless WithForce
+	// is passed. WithDetach deletes only the resource and keeps the Kafka topic, and
+	// WithWaitForDeletion waits until the resource is gone.
+	Undeploy(ctx context.Context, namespace string, name string, opts ...UndeployOption) error
+}
+
+// UndeployOptions holds the settings of a single TopicDeployer.Undeploy call.
+type UndeployOptions struct {
+	// Force deletes the topic even if it is protected.
+	Force bool
+
+	// Detach sets the ManagedAnnotation to "false" before the deletion, so the topic
+	// operator keeps the Kafka topic and its data.
+	Detach bool
+
+	// WaitTimeout is the maximum duration to wait until the resource is deleted.
+	// Zero does not wait.
+	WaitTimeout time.Duration
+}
+
+// UndeployOption configures a single TopicDeployer.Undeploy call.
+type UndeployOption func(*UndeployOptions)
+
+// WithForce makes Undeploy delete protected topics.
+func WithForce() UndeployOption {
+	return func(o *UndeployOptions) {
+		o.Force = true
+	}
+}
+
+// WithDetach makes Undeploy detach the topic from the topic operator before deleting
+// the resource, so the Kafka topic and its data are kept. Detaching is allowed for
+// protected topics.
+func WithDetach() UndeployOption {
+	return func(o *UndeployOptions) {
+		o.Detach = true
+	}
+}
+
+// WithWaitForDeletion makes Undeploy wait up to timeout until the resource is gone,
+// e.g. until the topic operator removed its finalizer after deleting the Kafka topic.
+// If the resource still exists, an error matching ErrTopicDeletionStuck listing the
+// remaining finalizers is returned.
+func WithWaitForDeletion(timeout time.Duration) UndeployOption {
+	return func(o *UndeployOptions) {
+		o.WaitTimeout = timeout
+	}
+}
+
+// DeployAction describes what TopicDeployer.DeployWithResult did with a topic.
+type DeployAction string
+
+const (
+	// DeployActionCreated means the topic did not exist and was created.
+	DeployActionCreated DeployAction = "Created"
+	// DeployActionUpdated means the topic existed and was updated.
+	DeployActionUpdated DeployAction = "Updated"
+	// DeployActionUnchanged means the topic already matched and no update was sent.
+	DeployActionUnchanged DeployAction = "Unchanged"
+	// DeployActionApplied means the topic was deployed with server-side apply. The API
+	// server skips applies that don't change anything, so no Get is issued to tell
+	// the other actions apart.
+	DeployActionApplied DeployAction = "Applied"
+)
+
+// String returns the action as string.
+func (d DeployAction) String() string {
+	return string(d)
+}
+
+// DeployResult describes the outcome of TopicDeployer.DeployWithResult.
+type DeployResult struct {
+	// Action is what was done with the topic.
+	Action DeployAction
+
+	// Diff describes the changes of the topic. It is not computed for server-side apply.
+	Diff TopicDiff
+
+	// Retries is the number of times the Get/merge/Update cycle was repeated because
+	// the topic was modified concurrently. A value above zero indicates contention.
+	Retries int
+}
+
+// TopicDeployerOptions holds the configuration of a TopicDeployer.
+type TopicDeployerOptions struct {
+	// APIVersion is the kafka.strimzi.io API version used to read and write
+	// KafkaTopics. Defaults to APIVersionV1beta2.
+	APIVersion APIVersion
+
+	// ServerSideApply deploys topics with server-side apply instead of Get and Update.
+	ServerSideApply bool
+
+	// FieldManager is the field manager used for server-side apply.
+	// Defaults to DefaultFieldManager.
+	FieldManager string
+
+	// ForceConflicts takes ownership of fields owned by other field managers
+	// instead of failing with ErrTopicFieldManagerConflict.
+	ForceConflicts bool
+
+	// ConflictBackoff controls how the Get/merge/Update cycle is retried if the topic
+	// was modified concurrently. Steps is the maximum number of attempts.
+	// Defaults to DefaultConflictBackoff.
+	ConflictBackoff wait.Backoff
+
+	// PartitionDecreasePolicy controls what happens if the desired topic has fewer
+	// partitions than the existing one. Defaults to SpecChangePolicyReject.
+	PartitionDecreasePolicy SpecChangePolicy
+
+	// ReplicasChangePolicy controls what happens if the desired topic has other
+	// replicas than the existing one. Defaults to SpecChangePolicyReject.
+	ReplicasChangePolicy SpecChangePolicy
+
+	// DryRun sends all writes with dryRun=All, so the API server validates them
+	// without persisting anything.
+	DryRun bool
+
+	// ProtectionPolicies protect additional topics from Undeploy without WithForce.
+	// Topics with the ProtectAnnotation are always protected.
+	ProtectionPolicies []TopicProtectionPolicy
+
+	// ValidateConfig validates the topic config before anything is sent to the API server.
+	ValidateConfig bool
+
+	// ClusterResolver picks the cluster of topics without the v1beta2.ClusterLabel.
+	// The label is left as is if nil.
+	ClusterResolver ClusterResolver
+
+	// ValidateCluster rejects topics without the v1beta2.ClusterLabel or whose Kafka
+	// cluster does not exist in the namespace of the topic.
+	ValidateCluster bool
+}
+
+// DefaultConflictBackoff is the backoff used to retry Deploy on conflicts if none is set.
+var DefaultConflictBackoff = retry.DefaultRetry
+
+// DefaultFieldManager is the field manager used for server-side apply if none is set.
+const DefaultFieldManager = "strimzi-topic-deployer"
+
+// TopicDeployerOption configures a TopicDeployer.
+type TopicDeployerOption func(*TopicDeployerOptions)
+
+// WithAPIVersion selects the kafka.strimzi.io API version the TopicDeployer targets.
+// Topics are always passed as v1beta2.KafkaTopic and converted if required,
+// which allows migrating clusters to v1 without changing callers.
+func WithAPIVersion(apiVersion APIVersion) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.APIVersion = apiVersion
+	}
+}
+
+// WithServerSideApply makes the TopicDeployer deploy topics with server-side apply
+// using the given field manager. Only labels, annotations and spec fields set on the
+// topic are applied, fields owned by other controllers are left untouched.
+// Unless both spec change policies are SpecChangePolicyAllow, the topic is read from
+// the API server before it is applied to guard partition and replica changes.
+// An empty field manager falls back to DefaultFieldManager.
+func WithServerSideApply(fieldManager string) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ServerSideApply = true
+		o.FieldManager = fieldManager
+	}
+}
+
+// WithForceConflicts makes server-side apply take ownership of conflicting fields
+// instead of failing with ErrTopicFieldManagerConflict.
+func WithForceConflicts() TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ForceConflicts = true
+	}
+}
+
+// WithConflictBackoff configures how Deploy retries the Get/merge/Update cycle if the
+// topic was modified concurrently, e.g. by the Strimzi topic operator writing status.
+// The Steps of the backoff is the maximum number of attempts, 1 disables retries.
+func WithConflictBackoff(backoff wait.Backoff) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ConflictBackoff = backoff
+	}
+}
+
+// WithPartitionDecreasePolicy configures how Deploy handles a partition decrease of an
+// existing topic, which Kafka does not support.
+func WithPartitionDecreasePolicy(policy SpecChangePolicy) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.PartitionDecreasePolicy = policy
+	}
+}
+
+// WithReplicasChangePolicy configures how Deploy handles a replicas change of an
+// existing topic. Use SpecChangePolicyAllow if the cluster runs Cruise Control.
+func WithReplicasChangePolicy(policy SpecChangePolicy) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ReplicasChangePolicy = policy
+	}
+}
+
+// WithDryRun makes the TopicDeployer send all writes as dry run. Combined with
+// DeployWithResult and its Diff, it shows what a deploy would change.
+func WithDryRun() TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.DryRun = true
+	}
+}
+
+// WithProtectionPolicies protects topics matching any of the policies from Undeploy
+// without WithForce, e.g. ProtectCompacted or ProtectInfiniteRetention().
+func WithProtectionPolicies(policies ...TopicProtectionPolicy) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ProtectionPolicies = append(o.ProtectionPolicies, policies...)
+	}
+}
+
+// WithConfigValidation makes Deploy check the topic config with
+// v1beta2.ValidateTopicConfig and fail with ErrTopicConfigInvalid on unknown keys or
+// invalid values, instead of waiting for the topic operator to report the topic NotReady.
+func WithConfigValidation() TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ValidateConfig = true
+	}
+}
+
+// WithClusterResolver makes Deploy set the v1beta2.ClusterLabel of topics without it to
+// the cluster picked by the resolver, e.g. NewNamespaceClusterResolver.
+func WithClusterResolver(clusterResolver ClusterResolver) TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ClusterResolver = clusterResolver
+	}
+}
+
+// WithDefaultCluster makes Deploy set the v1beta2.ClusterLabel of topics without it to
+// the given cluster.
+func WithDefaultCluster(cluster string) TopicDeployerOption {
+	return WithClusterResolver(NewStaticClusterResolver(cluster))
+}
+
+// WithClusterValidation makes Deploy fail with ErrTopicClusterMissing for topics without
+// the v1beta2.ClusterLabel, which the topic operator silently ignores, and with
+// ErrTopicClusterNotFound if the Kafka cluster of the label does not exist.
+func WithClusterValidation() TopicDeployerOption {
+	return func(o *TopicDeployerOptions) {
+		o.ValidateCluster = true
+	}
 }
 
 // NewTopicDeployer creates a new TopicDeployer instance.
 //
 // Parameters:
 //   - clientset: Strimzi clientset for interacting with KafkaTopic resources
+//   - opts: Optional settings, e.g. WithAPIVersion or WithServerSideApply
 //
 // Returns:
 //   - TopicDeployer: A new deployer instance for managing Kafka topics
 func NewTopicDeployer(
 	clientset versioned.Interface,
+	opts ...TopicDeployerOption,
 ) TopicDeployer {
+	options := newTopicDeployerOptions(opts...)
+	topicClient := newTopicClient(clientset, options.APIVersion)
 	return &topicDeployer{
-		clientset: clientset,
+		topicClient:     topicClient,
+		liveTopicClient: topicClient,
+		clusterClient:   newClusterClient(clientset, options.APIVersion),
+		options:         options,
+	}
+}
+
+func newTopicDeployerOptions(opts ...TopicDeployerOption) TopicDeployerOptions {
+	options := TopicDeployerOptions{
+		APIVersion:              APIVersionV1beta2,
+		ConflictBackoff:         DefaultConflictBackoff,
+		PartitionDecreasePolicy: SpecChangePolicyReject,
+		ReplicasChangePolicy:    SpecChangePolicyReject,
+	}
+	for _, opt := range opts {
+		opt(&options)
 	}
+	if options.FieldManager == "" {
+		options.FieldManager = DefaultFieldManager
+	}
+	if options.ConflictBackoff.Steps < 1 {
+		options.ConflictBackoff.Steps = 1
+	}
+	return options
 }
 
 type topicDeployer struct {
-	clientset versioned.Interface
+	topicClient topicClient
+	// liveTopicClient reads from the API server even if topicClient reads from a cache.
+	liveTopicClient topicClient
+	clusterClient   clusterClient
+	options         TopicDeployerOptions
 }
 
 func (t *topicDeployer) Deploy(ctx context.Context, topic v1beta2.KafkaTopic) error {
-	currentTopic, err := t.clientset.KafkaV1beta2().
-		KafkaTopics(topic.Namespace).
-		Get(ctx, topic.Name, metav1.GetOptions{})
+	_, err := t.DeployWithResult(ctx, topic)
+	return err
+}
+
+func (t *topicDeployer) DeployWithResult(
+	ctx context.Context,
+	topic v1beta2.KafkaTopic,
+) (DeployResult, error) {
+	var result DeployResult
+	if err := t.validateConfig(ctx, topic); err != nil {
+		return result, err
+	}
+	topic, err := t.resolveCluster(ctx, topic)
 	if err != nil {
-		glog.V(3).Infof("get topic %s failed: %s", topic.Name, err)
-		_, err = t.clientset.KafkaV1beta2().
-			KafkaTopics(topic.Namespace).
-			Create(ctx, &topic, metav1.CreateOptions{})
+		return result, err
+	}
+	if t.options.ServerSideApply {
+		topic, err = t.guardApply(ctx, topic)
 		if err != nil {
-			return errors.Wrap(ctx, err, "create topic failed")
+			return result, err
+		}
+		if err := t.apply(ctx, topic); err != nil {
+			return result, err
+		}
+		result.Action = DeployActionApplied
+		return result, nil
+	}
+	var attempts int
+	var lastErr error
+	err = wait.ExponentialBackoffWithContext(
+		ctx,
+		t.options.ConflictBackoff,
+		func(ctx context.Context) (bool, error) {
+			attempts++
+			reader := t.topicClient
+			if attempts > 1 {
+				// a cache may still hold the version that conflicted
+				reader = t.liveTopicClient
+			}
+			result.Diff, lastErr = t.deploy(ctx, reader, topic)
+			result.Action = result.Diff.Action
+			if lastErr == nil {
+				return true, nil
+			}
+			if isRetryableConflict(lastErr) {
+				glog.V(2).
+					Infof("deploy topic %s conflicted (attempt %d): %v", topic.Name, attempts, lastErr)
+				return false, nil
+			}
+			return false, lastErr
+		},
+	)
+	result.Retries = attempts - 1
+	if err != nil {
+		if wait.Interrupted(err) && lastErr != nil {
+			return result, errors.Wrapf(
+				ctx,
+				lastErr,
+				"deploy topic %s failed after %d attempts",
+				topic.Name,
+				attempts,
+			)
+		}
+		return result, err
+	}
+	if result.Retries > 0 {
+		glog.V(2).Infof("topic %s deployed after %d retries", topic.Name, result.Retries)
+	}
+	return result, nil
+}
+
+func (t *topicDeployer) deploy(
+	ctx context.Context,
+	reader topicClient,
+	topic v1beta2.KafkaTopic,
+) (TopicDiff, error) {
+	currentTopic, err := reader.Get(ctx, topic.Namespace, topic.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return TopicDiff{}, errors.Wrapf(
+				ctx,
+				topicError(err),
+				"get topic %s failed",
+				topic.Name,
+			)
+		}
+		glog.V(3).Infof("topic %s not found => create", topic.Name)
+		_, err = t.topicClient.Create(ctx, &topic, metav1.CreateOptions{DryRun: t.dryRun()})
+		if err != nil {
+			return TopicDiff{}, errors.Wrap(ctx, topicError(err), "create topic failed")
 		}
 		glog.V(3).Infof("topic %s created successful", topic.Name)
-		return nil
+		return NewTopicDiff(nil, topic), nil
+	}
+	topic, err = guardSpecChanges(
+		ctx,
+		*currentTopic,
+		topic,
+		t.options.PartitionDecreasePolicy,
+		t.options.ReplicasChangePolicy,
+	)
+	if err != nil {
+		return TopicDiff{}, err
+	}
+	diff := NewTopicDiff(currentTopic, topic)
+	if diff.Action == DeployActionUnchanged {
+		glog.V(3).Infof("topic %s unchanged => skip update", topic.Name)
+		return diff, nil
 	}
 	updateTopic := mergeTopic(*currentTopic, topic)
-	_, err = t.clientset.KafkaV1beta2().
-		KafkaTopics(topic.Namespace).
-		Update(ctx, &updateTopic, metav1.UpdateOptions{})
+	_, err = t.topicClient.Update(ctx, &updateTopic, metav1.UpdateOptions{DryRun: t.dryRun()})
 	if err != nil {
-		return errors.Wrap(ctx, err, "update topic failed")
+		return TopicDiff{}, errors.Wrap(ctx, topicError(err), "update topic failed")
 	}
 	glog.V(3).Infof("topic %s updated successful", topic.Name)
+	return diff, nil
+}
+
+// guardApply guards the spec changes of a topic that is deployed with server-side
+// apply. The current topic is read from the API server, so a cache never hides a change.
+func (t *topicDeployer) guardApply(
+	ctx context.Context,
+	topic v1beta2.KafkaTopic,
+) (v1beta2.KafkaTopic, error) {
+	if t.options.PartitionDecreasePolicy == SpecChangePolicyAllow &&
+		t.options.ReplicasChangePolicy == SpecChangePolicyAllow {
+		return topic, nil
+	}
+	currentTopic, err := t.liveTopicClient.Get(ctx, topic.Namespace, topic.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return topic, nil
+		}
+		return topic, errors.Wrapf(ctx, topicError(err), "get topic %s failed", topic.Name)
+	}
+	return guardSpecChanges(
+		ctx,
+		*currentTopic,
+		topic,
+		t.options.PartitionDecreasePolicy,
+		t.options.ReplicasChangePolicy,
+	)
+}
+
+func (t *topicDeployer) apply(ctx context.Context, topic v1beta2.KafkaTopic) error {
+	_, err := t.topicClient.Apply(ctx, &topic, metav1.ApplyOptions{
+		FieldManager: t.options.FieldManager,
+		Force:        t.options.ForceConflicts,
+		DryRun:       t.dryRun(),
+	})
+	if err != nil {
+		return errors.Wrapf(ctx, topicError(err), "apply topic %s failed", topic.Name)
+	}
+	glog.V(3).Infof("topic %s applied successful", topic.Name)
 	return nil
 }
 
-func (t *topicDeployer) Undeploy(ctx context.Context, namespace string, name string) error {
-	_, err := t.clientset.KafkaV1beta2().KafkaTopics(namespace).Get(ctx, name, metav1.GetOptions{})
+func (t *topicDeployer) DeployAndWait(
+	ctx context.Context,
+	topic v1beta2.KafkaTopic,
+	timeout time.Duration,
+) (DeployResult, error) {
+	result, err := t.DeployWithResult(ctx, topic)
 	if err != nil {
-		glog.V(3).Infof("topic '%s' not found => skip", name)
-		return nil
+		return result, err
+	}
+	if t.options.DryRun {
+		glog.V(3).Infof("dry run => skip wait for topic %s", topic.Name)
+		return result, nil
+	}
+	if _, err := t.WaitForReady(ctx, topic.Namespace, topic.Name, timeout); err != nil {
+		return result, err
 	}
-	if err := t.clientset.KafkaV1beta2().KafkaTopics(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
-		return err
+	return result, nil
+}
+
+func (t *topicDeployer) WaitForReady(
+	ctx context.Context,
+	namespace string,
+	name string,
+	timeout time.Duration,
+) (*v1beta2.KafkaTopic, error) {
+	lw, objType, err := t.topicClient.ListWatch(ctx, namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "wait for topic %s ready failed", name)
+	}
+	event, err := waitUntil(
+		ctx,
+		timeout,
+		lw,
+		objType,
+		nil,
+		func(event watch.Event) (bool, error) {
+			topic, ok := asV1beta2Topic(event.Object)
+			if !ok || topic.Name != name {
+				return false, nil
+			}
+			if event.Type == watch.Deleted {
+				return false, errors.Errorf(ctx, "topic %s deleted", name)
+			}
+			return topicReady(ctx, *topic)
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "wait for topic %s ready failed", name)
+	}
+	topic, _ := asV1beta2Topic(event.Object)
+	glog.V(3).Infof("topic %s is ready", name)
+	return topic, nil
+}
+
+func (t *topicDeployer) Undeploy(
+	ctx context.Context,
+	namespace string,
+	name string,
+	opts ...UndeployOption,
+) error {
+	var undeployOptions UndeployOptions
+	for _, opt := range opts {
+		opt(&undeployOptions)
+	}
+	// the protection check and the detach must not act on a stale cached topic
+	currentTopic, err := t.liveTopicClient.Get(ctx, namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			glog.V(3).Infof("topic '%s' not found => skip", name)
+			return nil
+		}
+		return errors.Wrapf(ctx, topicError(err), "get topic %s failed", name)
+	}
+	if undeployOptions.Detach {
+		if err := t.detach(ctx, *currentTopic); err != nil {
+			return err
+		}
+	} else if reason, ok := topicProtected(*currentTopic, t.options.ProtectionPolicies); ok {
+		if !undeployOptions.Force {
+			return errors.Wrapf(
+				ctx,
+				ErrTopicProtected,
+				"undeploy topic %s refused because of %s",
+				name,
+				reason,
+			)
+		}
+		glog.V(2).Infof("topic '%s' is protected by %s => force delete", name, reason)
+	}
+	err = t.topicClient.Delete(ctx, namespace, name, metav1.DeleteOptions{DryRun: t.dryRun()})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			glog.V(3).Infof("topic '%s' already deleted", name)
+			return nil
+		}
+		return errors.Wrapf(ctx, topicError(err), "delete topic %s failed", name)
+	}
+	if undeployOptions.WaitTimeout > 0 && !t.options.DryRun {
+		if err := t.waitForDeletion(ctx, namespace, name, undeployOptions.WaitTimeout); err != nil {
+			return err
+		}
 	}
 	glog.V(3).Infof("delete %s completed", name)
 	return nil
 }
 
+// detach sets the ManagedAnnotation to "false", so the topic operator ignores the
+// following deletion of the resource.
+func (t *topicDeployer) detach(ctx context.Context, topic v1beta2.KafkaTopic) error {
+	if topic.Annotations[v1beta2.ManagedAnnotation] == "false" {
+		return nil
+	}
+	updateTopic := topic.DeepCopy()
+	if updateTopic.Annotations == nil {
+		updateTopic.Annotations = map[string]string{}
+	}
+	updateTopic.Annotations[v1beta2.ManagedAnnotation] = "false"
+	_, err := t.topicClient.Update(ctx, updateTopic, metav1.UpdateOptions{DryRun: t.dryRun()})
+	if err != nil {
+		return errors.Wrapf(ctx, topicError(err), "detach topic %s failed", topic.Name)
+	}
+	glog.V(3).Infof("topic '%s' detached from topic operator", topic.Name)
+	return nil
+}
+
+// waitForDeletion watches the topic until it is deleted. After the timeout it reports
+// the finalizers that block the deletion.
+func (t *topicDeployer) waitForDeletion(
+	ctx context.Context,
+	namespace string,
+	name string,
+	timeout time.Duration,
+) error {
+	lw, objType, err := t.topicClient.ListWatch(ctx, namespace, name)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "wait for deletion of topic %s failed", name)
+	}
+	_, err = waitUntil(
+		ctx,
+		timeout,
+		lw,
+		objType,
+		func(store cache.Store) (bool, error) {
+			_, exists, err := store.GetByKey(namespace + "/" + name)
+			return !exists, err
+		},
+		func(event watch.Event) (bool, error) {
+			topic, ok := asV1beta2Topic(event.Object)
+			return ok && topic.Name == name && event.Type == watch.Deleted, nil
+		},
+	)
+	if err == nil {
+		return nil
+	}
+	currentTopic, getErr := t.liveTopicClient.Get(ctx, namespace, name)
+	if apierrors.IsNotFound(getErr) {
+		return nil
+	}
+	if getErr != nil {
+		return errors.Wrapf(ctx, err, "wait for deletion of topic %s failed", name)
+	}
+	return errors.Wrapf(
+		ctx,
+		ErrTopicDeletionStuck,
+		"topic %s still exists with finalizers %v: %v",
+		name,
+		currentTopic.Finalizers,
+		err,
+	)
+}
+
+func (t *topicDeployer) validateConfig(ctx context.Context, topic v1beta2.KafkaTopic) error {
+	if !t.options.ValidateConfig || topic.Spec == nil {
+		return nil
+	}
+	if err := v1beta2.ValidateTopicConfig(ctx, topic.Spec.Config); err != nil {
+		return errors.Wrapf(ctx, ErrTopicConfigInvalid, "topic %s: %v", topic.Name, err)
+	}
+	return nil
+}
+
+// resolveCluster sets the cluster label with the ClusterResolver if it is missing and
+// checks the cluster exists if ValidateCluster is set.
+func (t *topicDeployer) resolveCluster(
+	ctx context.Context,
+	topic v1beta2.KafkaTopic,
+) (v1beta2.KafkaTopic, error) {
+	cluster := topic.Labels[v1beta2.ClusterLabel]
+	if cluster == "" && t.options.ClusterResolver != nil {
+		var err error
+		cluster, err = t.options.ClusterResolver.ResolveCluster(ctx, topic)
+		if err != nil {
+			return topic, errors.Wrapf(ctx, err, "resolve cluster of topic %s failed", topic.Name)
+		}
+		if cluster != "" {
+			glog.V(3).Infof("topic %s has no cluster label => use cluster %s", topic.Name, cluster)
+			topic = *topic.DeepCopy()
+			if topic.Labels == nil {
+				topic.Labels = map[string]string{}
+			}
+			topic.Labels[v1beta2.ClusterLabel] = cluster
+		}
+	}
+	if !t.options.ValidateCluster {
+		return topic, nil
+	}
+	if cluster == "" {
+		return topic, errors.Wrapf(
+			ctx,
+			ErrTopicClusterMissing,
+			"topic %s has no label %s",
+			topic.Name,
+			v1beta2.ClusterLabel,
+		)
+	}
+	exists, err := t.clusterClient.Exists(ctx, topic.Namespace, cluster)
+	if err != nil {
+		return topic, errors.Wrapf(
+			ctx,
+			topicError(err),
+			"get kafka cluster %s of topic %s failed",
+			cluster,
+			topic.Name,
+		)
+	}
+	if !exists {
+		return topic, errors.Wrapf(
+			ctx,
+			ErrTopicClusterNotFound,
+			"kafka cluster %s of topic %s not found in namespace %s",
+			cluster,
+			topic.Name,
+			topic.Namespace,
+		)
+	}
+	return topic, nil
+}
+
+func (t *topicDeployer) dryRun() []string {
+	if t.options.DryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// mergeTopic applies the spec, labels and annotations of the new topic to the current
+// topic. Finalizers and labels and annotations added by others survive the update.
 func mergeTopic(currentTopic, newTopic v1beta2.KafkaTopic) v1beta2.KafkaTopic {
-	newTopic.ResourceVersion = currentTopic.ResourceVersion
-	return newTopic
+	result := currentTopic.DeepCopy()
+	result.Labels = mergeMaps(result.Labels, newTopic.Labels)
+	result.Annotations = mergeMaps(result.Annotations, newTopic.Annotations)
+	result.Spec = newTopic.Spec.DeepCopy()
+	return *result
+}
+
+// mergeMaps sets the desired keys in current. Keys owned by others are kept.
+func mergeMaps(current map[string]string, desired map[string]string) map[string]string {
+	if len(desired) == 0 {
+		return current
+	}
+	if current == nil {
+		current = make(map[string]string, len(desired))
+	}
+	for key, value := range desired {
+		current[key] = value
+	}
+	return current
+}
+
+// topicUpToDate reports whether the current topic is equal to the desired topic and
+// carries all of its labels and annotations. Labels and annotations added by others are
+// ignored.
+func topicUpToDate(currentTopic, desiredTopic v1beta2.KafkaTopic) bool {
+	if !currentTopic.Equal(desiredTopic) {
+		return false
+	}
+	return containsAll(currentTopic.Labels, desiredTopic.Labels) &&
+		containsAll(currentTopic.Annotations, desiredTopic.Annotations)
+}
+
+func containsAll(current map[string]string, desired map[string]string) bool {
+	for key, value := range desired {
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// topicReady reports whether the topic operator has reconciled the current generation
+// of the topic and reports it Ready. A Ready condition of False is returned as
+// ErrTopicNotReady.
+func topicReady(ctx context.Context, topic v1beta2.KafkaTopic) (bool, error) {
+	if !topic.Status.IsReconciled(topic.Generation) {
+		return false, nil
+	}
+	condition := topic.Status.GetCondition(v1beta2.KafkaTopicConditionReady)
+	switch {
+	case condition.IsTrue():
+		return true, nil
+	case condition.IsFalse():
+		return false, errors.Wrapf(
+			ctx,
+			ErrTopicNotReady,
+			"topic %s is not ready: %s",
+			topic.Name,
+			condition.String(),
+		)
+	default:
+		return false, nil
+	}
 }
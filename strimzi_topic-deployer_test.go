@@ -0,0 +1,1322 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/bborbe/strimzi"
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	applyv1beta2 "github.com/bborbe/strimzi/k8s/client/applyconfiguration/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("TopicDeployer", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var topicDeployer strimzi.TopicDeployer
+	var topic v1beta2.KafkaTopic
+	var err error
+
+	topicResource := v1beta2.Resource("kafkatopics")
+	reactWith := func(verb string, reactErr error) {
+		clientset.PrependReactor(
+			verb,
+			"kafkatopics",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, reactErr
+			},
+		)
+	}
+	countActions := func(verb string) int {
+		var counter int
+		for _, action := range clientset.Actions() {
+			if action.GetVerb() == verb {
+				counter++
+			}
+		}
+		return counter
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		topic = v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-topic",
+				Namespace: "kafka",
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+				Replicas:   collection.Ptr(int32(2)),
+			},
+		}
+	})
+
+	Context("v1beta2", func() {
+		BeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset)
+		})
+		Context("Deploy", func() {
+			JustBeforeEach(func() {
+				err = topicDeployer.Deploy(ctx, topic)
+			})
+			Context("topic does not exist", func() {
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("creates the topic", func() {
+					result, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Get(ctx, "my-topic", metav1.GetOptions{})
+					Expect(err).To(BeNil())
+					Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				})
+			})
+			Context("topic exists", func() {
+				BeforeEach(func() {
+					existing := topic.DeepCopy()
+					existing.ResourceVersion = "42"
+					existing.Spec.Partitions = collection.Ptr(int32(1))
+					_, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Create(ctx, existing, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("updates the topic", func() {
+					result, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Get(ctx, "my-topic", metav1.GetOptions{})
+					Expect(err).To(BeNil())
+					Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				})
+			})
+			Context("get is forbidden", func() {
+				BeforeEach(func() {
+					reactWith(
+						"get",
+						apierrors.NewForbidden(topicResource, "my-topic", stderrors.New("rbac")),
+					)
+				})
+				It("returns ErrTopicForbidden", func() {
+					Expect(err).NotTo(BeNil())
+					Expect(stderrors.Is(err, strimzi.ErrTopicForbidden)).To(BeTrue())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+				It("does not create the topic", func() {
+					Expect(countActions("create")).To(Equal(0))
+				})
+			})
+			Context("get fails with internal error", func() {
+				BeforeEach(func() {
+					reactWith("get", apierrors.NewInternalError(stderrors.New("banana")))
+				})
+				It("returns the error", func() {
+					Expect(err).NotTo(BeNil())
+					Expect(err.Error()).To(ContainSubstring("banana"))
+					Expect(stderrors.Is(err, strimzi.ErrTopicForbidden)).To(BeFalse())
+					Expect(stderrors.Is(err, strimzi.ErrTopicConflict)).To(BeFalse())
+				})
+				It("does not create the topic", func() {
+					Expect(countActions("create")).To(Equal(0))
+				})
+			})
+			Context("create is forbidden", func() {
+				BeforeEach(func() {
+					reactWith(
+						"create",
+						apierrors.NewForbidden(topicResource, "my-topic", stderrors.New("rbac")),
+					)
+				})
+				It("returns ErrTopicForbidden", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicForbidden)).To(BeTrue())
+				})
+			})
+			Context("create conflicts", func() {
+				BeforeEach(func() {
+					reactWith("create", apierrors.NewAlreadyExists(topicResource, "my-topic"))
+				})
+				It("returns ErrTopicConflict", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicConflict)).To(BeTrue())
+					Expect(apierrors.IsAlreadyExists(err)).To(BeTrue())
+				})
+			})
+			Context("update conflicts", func() {
+				BeforeEach(func() {
+					existing := topic.DeepCopy()
+					existing.Spec.Partitions = collection.Ptr(int32(1))
+					_, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Create(ctx, existing, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+					reactWith(
+						"update",
+						apierrors.NewConflict(topicResource, "my-topic", stderrors.New("changed")),
+					)
+				})
+				It("returns ErrTopicConflict", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicConflict)).To(BeTrue())
+					Expect(apierrors.IsConflict(err)).To(BeTrue())
+				})
+			})
+		})
+		Context("Undeploy", func() {
+			JustBeforeEach(func() {
+				err = topicDeployer.Undeploy(ctx, "kafka", "my-topic")
+			})
+			Context("topic does not exist", func() {
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+			})
+			Context("topic exists", func() {
+				BeforeEach(func() {
+					_, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Create(ctx, &topic, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+				})
+				It("deletes the topic", func() {
+					Expect(err).To(BeNil())
+					list, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						List(ctx, metav1.ListOptions{})
+					Expect(err).To(BeNil())
+					Expect(list.Items).To(BeEmpty())
+				})
+			})
+			Context("get is forbidden", func() {
+				BeforeEach(func() {
+					reactWith(
+						"get",
+						apierrors.NewForbidden(topicResource, "my-topic", stderrors.New("rbac")),
+					)
+				})
+				It("returns ErrTopicForbidden", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicForbidden)).To(BeTrue())
+				})
+				It("does not delete the topic", func() {
+					Expect(countActions("delete")).To(Equal(0))
+				})
+			})
+			Context("get fails with internal error", func() {
+				BeforeEach(func() {
+					reactWith("get", apierrors.NewInternalError(stderrors.New("banana")))
+				})
+				It("returns the error", func() {
+					Expect(err).NotTo(BeNil())
+					Expect(err.Error()).To(ContainSubstring("banana"))
+				})
+			})
+			Context("delete is forbidden", func() {
+				BeforeEach(func() {
+					_, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Create(ctx, &topic, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+					reactWith(
+						"delete",
+						apierrors.NewForbidden(topicResource, "my-topic", stderrors.New("rbac")),
+					)
+				})
+				It("returns ErrTopicForbidden", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicForbidden)).To(BeTrue())
+				})
+			})
+			Context("topic is deleted concurrently", func() {
+				BeforeEach(func() {
+					_, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Create(ctx, &topic, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+					reactWith("delete", apierrors.NewNotFound(topicResource, "my-topic"))
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Context("v1", func() {
+		BeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(
+				clientset,
+				strimzi.WithAPIVersion(strimzi.APIVersionV1),
+			)
+		})
+		Context("Deploy", func() {
+			JustBeforeEach(func() {
+				err = topicDeployer.Deploy(ctx, topic)
+			})
+			Context("topic does not exist", func() {
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("creates the topic with the v1 api", func() {
+					result, err := clientset.KafkaV1().
+						KafkaTopics("kafka").
+						Get(ctx, "my-topic", metav1.GetOptions{})
+					Expect(err).To(BeNil())
+					Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				})
+				It("does not use the v1beta2 api", func() {
+					list, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						List(ctx, metav1.ListOptions{})
+					Expect(err).To(BeNil())
+					Expect(list.Items).To(BeEmpty())
+				})
+			})
+			Context("topic exists", func() {
+				BeforeEach(func() {
+					existing := kafkav1.KafkaTopicFromV1beta2(topic)
+					existing.ResourceVersion = "42"
+					existing.Spec.Partitions = collection.Ptr(int32(1))
+					_, err := clientset.KafkaV1().
+						KafkaTopics("kafka").
+						Create(ctx, &existing, metav1.CreateOptions{})
+					Expect(err).To(BeNil())
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("updates the topic", func() {
+					result, err := clientset.KafkaV1().
+						KafkaTopics("kafka").
+						Get(ctx, "my-topic", metav1.GetOptions{})
+					Expect(err).To(BeNil())
+					Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				})
+			})
+		})
+		Context("Undeploy", func() {
+			BeforeEach(func() {
+				existing := kafkav1.KafkaTopicFromV1beta2(topic)
+				_, err := clientset.KafkaV1().
+					KafkaTopics("kafka").
+					Create(ctx, &existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			JustBeforeEach(func() {
+				err = topicDeployer.Undeploy(ctx, "kafka", "my-topic")
+			})
+			It("deletes the topic", func() {
+				Expect(err).To(BeNil())
+				list, err := clientset.KafkaV1().
+					KafkaTopics("kafka").
+					List(ctx, metav1.ListOptions{})
+				Expect(err).To(BeNil())
+				Expect(list.Items).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("DeployWithResult", func() {
+		var result strimzi.DeployResult
+		var conflicts int
+		BeforeEach(func() {
+			conflicts = 0
+			topicDeployer = strimzi.NewTopicDeployer(
+				clientset,
+				strimzi.WithConflictBackoff(wait.Backoff{Steps: 3, Duration: time.Millisecond}),
+			)
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Create(ctx, topic.DeepCopy(), metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		})
+		conflictTimes := func(times int) {
+			clientset.PrependReactor(
+				"update",
+				"kafkatopics",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if conflicts >= times {
+						return false, nil, nil
+					}
+					conflicts++
+					return true, nil, apierrors.NewConflict(
+						topicResource,
+						"my-topic",
+						stderrors.New("the object has been modified"),
+					)
+				},
+			)
+		}
+		JustBeforeEach(func() {
+			topic.Spec.Partitions = collection.Ptr(int32(6))
+			result, err = topicDeployer.DeployWithResult(ctx, topic)
+		})
+		Context("without conflict", func() {
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("reports no retries", func() {
+				Expect(result.Retries).To(Equal(0))
+			})
+			It("reports the topic as updated", func() {
+				Expect(result.Action).To(Equal(strimzi.DeployActionUpdated))
+			})
+		})
+		Context("conflict resolves", func() {
+			BeforeEach(func() {
+				conflictTimes(2)
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("reports the retries", func() {
+				Expect(result.Retries).To(Equal(2))
+			})
+			It("reads the topic again before each attempt", func() {
+				Expect(countActions("get")).To(Equal(3))
+			})
+			It("updates the topic", func() {
+				current, err := clientset.KafkaV1beta2().
+					KafkaTopics("kafka").
+					Get(ctx, "my-topic", metav1.GetOptions{})
+				Expect(err).To(BeNil())
+				Expect(*current.Spec.Partitions).To(Equal(int32(6)))
+			})
+		})
+		Context("conflict persists", func() {
+			BeforeEach(func() {
+				conflictTimes(10)
+			})
+			It("returns ErrTopicConflict", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicConflict)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("after 3 attempts"))
+			})
+			It("stops after the max attempts", func() {
+				Expect(conflicts).To(Equal(3))
+				Expect(result.Retries).To(Equal(2))
+			})
+		})
+		Context("conflict resolves without update", func() {
+			BeforeEach(func() {
+				clientset.PrependReactor(
+					"update",
+					"kafkatopics",
+					func(action k8stesting.Action) (bool, runtime.Object, error) {
+						if conflicts > 0 {
+							return false, nil, nil
+						}
+						conflicts++
+						concurrent := topic.DeepCopy()
+						concurrent.Spec.Partitions = collection.Ptr(int32(6))
+						Expect(clientset.Tracker().Update(
+							topicResource.WithVersion("v1beta2"),
+							concurrent,
+							"kafka",
+							metav1.UpdateOptions{},
+						)).To(Succeed())
+						return true, nil, apierrors.NewConflict(
+							topicResource,
+							"my-topic",
+							stderrors.New("the object has been modified"),
+						)
+					},
+				)
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("reports the topic as unchanged", func() {
+				Expect(result.Action).To(Equal(strimzi.DeployActionUnchanged))
+				Expect(result.Retries).To(Equal(1))
+			})
+		})
+		Context("other error", func() {
+			BeforeEach(func() {
+				reactWith("update", apierrors.NewInternalError(stderrors.New("banana")))
+			})
+			It("does not retry", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(result.Retries).To(Equal(0))
+				Expect(countActions("update")).To(Equal(1))
+			})
+		})
+		Context("context is canceled", func() {
+			BeforeEach(func() {
+				conflictTimes(10)
+				topicDeployer = strimzi.NewTopicDeployer(
+					clientset,
+					strimzi.WithConflictBackoff(wait.Backoff{Steps: 100, Duration: time.Hour}),
+				)
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, 10*time.Millisecond)
+				DeferCleanup(cancel)
+			})
+			It("returns an error", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(conflicts).To(Equal(1))
+			})
+		})
+	})
+
+	Context("DeployWithResult action", func() {
+		var result strimzi.DeployResult
+		var existing *v1beta2.KafkaTopic
+		BeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset)
+			topic.Labels = map[string]string{"app": "my-app"}
+			topic.Annotations = map[string]string{"owner": "team-a"}
+			existing = topic.DeepCopy()
+			existing.Labels["strimzi.io/cluster"] = "my-cluster"
+			existing.Finalizers = []string{"strimzi.io/topic-operator"}
+		})
+		JustBeforeEach(func() {
+			result, err = topicDeployer.DeployWithResult(ctx, topic)
+		})
+		Context("topic does not exist", func() {
+			It("reports the topic as created", func() {
+				Expect(err).To(BeNil())
+				Expect(result.Action).To(Equal(strimzi.DeployActionCreated))
+			})
+		})
+		Context("topic exists", func() {
+			BeforeEach(func() {
+				_, err := clientset.KafkaV1beta2().
+					KafkaTopics("kafka").
+					Create(ctx, existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			Context("and matches", func() {
+				It("reports the topic as unchanged", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Action).To(Equal(strimzi.DeployActionUnchanged))
+				})
+				It("does not update the topic", func() {
+					Expect(countActions("update")).To(Equal(0))
+				})
+			})
+			Context("and a label differs", func() {
+				BeforeEach(func() {
+					topic.Labels = map[string]string{"app": "other-app"}
+				})
+				It("reports the topic as updated", func() {
+					Expect(err).To(BeNil())
+					Expect(result.Action).To(Equal(strimzi.DeployActionUpdated))
+					Expect(countActions("update")).To(Equal(1))
+				})
+			})
+			Context("and an annotation is missing", func() {
+				BeforeEach(func() {
+					topic.Annotations["description"] = "my topic"
+				})
+				It("reports the topic as updated", func() {
+					Expect(result.Action).To(Equal(strimzi.DeployActionUpdated))
+				})
+			})
+			Context("and the config differs", func() {
+				BeforeEach(func() {
+					topic.Spec.Config = map[string]string{"retention.ms": "604800000"}
+				})
+				It("reports the topic as updated", func() {
+					Expect(result.Action).To(Equal(strimzi.DeployActionUpdated))
+				})
+				It("keeps the finalizer and foreign labels", func() {
+					current, err := clientset.KafkaV1beta2().
+						KafkaTopics("kafka").
+						Get(ctx, "my-topic", metav1.GetOptions{})
+					Expect(err).To(BeNil())
+					Expect(current.Spec.Config).To(HaveKeyWithValue("retention.ms", "604800000"))
+					Expect(current.Finalizers).To(ConsistOf("strimzi.io/topic-operator"))
+					Expect(current.Labels).To(HaveKeyWithValue("strimzi.io/cluster", "my-cluster"))
+					Expect(current.Labels).To(HaveKeyWithValue("app", "my-app"))
+				})
+			})
+		})
+	})
+
+	Context("spec changes", func() {
+		var opts []strimzi.TopicDeployerOption
+		get := func() *v1beta2.KafkaTopic {
+			result, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Get(ctx, "my-topic", metav1.GetOptions{})
+			Expect(err).To(BeNil())
+			return result
+		}
+		BeforeEach(func() {
+			opts = nil
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Create(ctx, topic.DeepCopy(), metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		})
+		JustBeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset, opts...)
+			err = topicDeployer.Deploy(ctx, topic)
+		})
+		Context("partitions are increased", func() {
+			BeforeEach(func() {
+				topic.Spec.Partitions = collection.Ptr(int32(6))
+			})
+			It("updates the topic", func() {
+				Expect(err).To(BeNil())
+				Expect(*get().Spec.Partitions).To(Equal(int32(6)))
+			})
+		})
+		Context("partitions are decreased", func() {
+			BeforeEach(func() {
+				topic.Spec.Partitions = collection.Ptr(int32(1))
+			})
+			It("returns ErrTopicPartitionDecrease", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicPartitionDecrease)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("from 3 to 1"))
+			})
+			It("does not update the topic", func() {
+				Expect(countActions("update")).To(Equal(0))
+				Expect(*get().Spec.Partitions).To(Equal(int32(3)))
+			})
+			Context("with keep current", func() {
+				BeforeEach(func() {
+					opts = append(
+						opts,
+						strimzi.WithPartitionDecreasePolicy(strimzi.SpecChangePolicyKeepCurrent),
+					)
+					topic.Spec.Config = map[string]string{"retention.ms": "604800000"}
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("keeps the current partitions", func() {
+					Expect(*get().Spec.Partitions).To(Equal(int32(3)))
+				})
+				It("updates the other fields", func() {
+					Expect(get().Spec.Config).To(HaveKeyWithValue("retention.ms", "604800000"))
+				})
+				It("does not modify the given topic", func() {
+					Expect(*topic.Spec.Partitions).To(Equal(int32(1)))
+				})
+			})
+		})
+		Context("replicas are changed", func() {
+			BeforeEach(func() {
+				topic.Spec.Replicas = collection.Ptr(int32(3))
+			})
+			It("returns ErrTopicReplicasChange", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicReplicasChange)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("from 2 to 3"))
+			})
+			Context("with keep current", func() {
+				BeforeEach(func() {
+					opts = append(
+						opts,
+						strimzi.WithReplicasChangePolicy(strimzi.SpecChangePolicyKeepCurrent),
+					)
+				})
+				It("keeps the current replicas", func() {
+					Expect(err).To(BeNil())
+					Expect(*get().Spec.Replicas).To(Equal(int32(2)))
+				})
+			})
+			Context("with allow", func() {
+				BeforeEach(func() {
+					opts = append(
+						opts,
+						strimzi.WithReplicasChangePolicy(strimzi.SpecChangePolicyAllow),
+					)
+				})
+				It("updates the replicas", func() {
+					Expect(err).To(BeNil())
+					Expect(*get().Spec.Replicas).To(Equal(int32(3)))
+				})
+			})
+		})
+		Context("replicas are removed", func() {
+			BeforeEach(func() {
+				topic.Spec.Replicas = nil
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Context("WaitForReady", func() {
+		var result *v1beta2.KafkaTopic
+		var timeout time.Duration
+		withStatus := func(generation int64, observedGeneration int32, status string) *v1beta2.KafkaTopic {
+			readyTopic := topic.DeepCopy()
+			readyTopic.Generation = generation
+			readyTopic.Status = &v1beta2.KafkaTopicStatus{
+				ObservedGeneration: collection.Ptr(observedGeneration),
+				Conditions: []v1beta2.KafkaTopicStatusConditionsElem{
+					{
+						Type:    collection.Ptr("Ready"),
+						Status:  collection.Ptr(status),
+						Reason:  collection.Ptr("KafkaError"),
+						Message: collection.Ptr("partitions can not be decreased"),
+					},
+				},
+			}
+			return readyTopic
+		}
+		create := func(obj *v1beta2.KafkaTopic) {
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Create(ctx, obj, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		}
+		BeforeEach(func() {
+			timeout = time.Second
+			topicDeployer = strimzi.NewTopicDeployer(clientset)
+		})
+		JustBeforeEach(func() {
+			result, err = topicDeployer.WaitForReady(ctx, "kafka", "my-topic", timeout)
+		})
+		Context("topic is ready", func() {
+			BeforeEach(func() {
+				create(withStatus(2, 2, "True"))
+			})
+			It("returns the topic", func() {
+				Expect(err).To(BeNil())
+				Expect(result).NotTo(BeNil())
+				Expect(result.Name).To(Equal("my-topic"))
+			})
+		})
+		Context("topic is not ready", func() {
+			BeforeEach(func() {
+				create(withStatus(2, 2, "False"))
+			})
+			It("returns ErrTopicNotReady with reason and message", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicNotReady)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("KafkaError"))
+				Expect(err.Error()).To(ContainSubstring("partitions can not be decreased"))
+			})
+		})
+		Context("generation is not reconciled", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				create(withStatus(3, 2, "False"))
+			})
+			It("returns an error after the timeout", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(stderrors.Is(err, strimzi.ErrTopicNotReady)).To(BeFalse())
+			})
+		})
+		Context("topic has no status", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				create(topic.DeepCopy())
+			})
+			It("returns an error after the timeout", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("v1", func() {
+			BeforeEach(func() {
+				topicDeployer = strimzi.NewTopicDeployer(
+					clientset,
+					strimzi.WithAPIVersion(strimzi.APIVersionV1),
+				)
+				v1Topic := kafkav1.KafkaTopicFromV1beta2(*withStatus(2, 2, "True"))
+				_, err := clientset.KafkaV1().
+					KafkaTopics("kafka").
+					Create(ctx, &v1Topic, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+			})
+			It("returns the topic as v1beta2", func() {
+				Expect(err).To(BeNil())
+				Expect(*result.Status.ObservedGeneration).To(Equal(int32(2)))
+			})
+		})
+	})
+
+	It("DeployAndWait waits until the topic becomes ready", func() {
+		topicDeployer = strimzi.NewTopicDeployer(clientset)
+		done := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			result, err := topicDeployer.DeployAndWait(ctx, topic, 5*time.Second)
+			Expect(result.Action).To(Equal(strimzi.DeployActionCreated))
+			done <- err
+		}()
+		Eventually(func() int {
+			return countActions("watch")
+		}).Should(BeNumerically(">", 0))
+
+		readyTopic, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			Get(ctx, "my-topic", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		readyTopic.Status = &v1beta2.KafkaTopicStatus{
+			ObservedGeneration: collection.Ptr(int32(0)),
+			Conditions: []v1beta2.KafkaTopicStatusConditionsElem{
+				{Type: collection.Ptr("Ready"), Status: collection.Ptr("True")},
+			},
+		}
+		_, err = clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			UpdateStatus(ctx, readyTopic, metav1.UpdateOptions{})
+		Expect(err).To(BeNil())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	Context("Undeploy protected topic", func() {
+		var opts []strimzi.TopicDeployerOption
+		var undeployOpts []strimzi.UndeployOption
+		exists := func() bool {
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Get(ctx, "my-topic", metav1.GetOptions{})
+			return err == nil
+		}
+		BeforeEach(func() {
+			opts = nil
+			undeployOpts = nil
+			topic.Annotations = map[string]string{strimzi.ProtectAnnotation: "true"}
+		})
+		JustBeforeEach(func() {
+			_, createErr := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Create(ctx, &topic, metav1.CreateOptions{})
+			Expect(createErr).To(BeNil())
+			topicDeployer = strimzi.NewTopicDeployer(clientset, opts...)
+			err = topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)
+		})
+		It("returns ErrTopicProtected", func() {
+			Expect(stderrors.Is(err, strimzi.ErrTopicProtected)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(strimzi.ProtectAnnotation))
+		})
+		It("keeps the topic", func() {
+			Expect(exists()).To(BeTrue())
+			Expect(countActions("delete")).To(Equal(0))
+		})
+		Context("with force", func() {
+			BeforeEach(func() {
+				undeployOpts = append(undeployOpts, strimzi.WithForce())
+			})
+			It("deletes the topic", func() {
+				Expect(err).To(BeNil())
+				Expect(exists()).To(BeFalse())
+			})
+		})
+		Context("annotation is false", func() {
+			BeforeEach(func() {
+				topic.Annotations[strimzi.ProtectAnnotation] = "false"
+			})
+			It("deletes the topic", func() {
+				Expect(err).To(BeNil())
+				Expect(exists()).To(BeFalse())
+			})
+		})
+		Context("topic is compacted", func() {
+			BeforeEach(func() {
+				topic.Annotations = nil
+				topic.Spec.Config = map[string]string{"cleanup.policy": "compact"}
+			})
+			It("deletes the topic without policy", func() {
+				Expect(err).To(BeNil())
+				Expect(exists()).To(BeFalse())
+			})
+			Context("with compacted policy", func() {
+				BeforeEach(func() {
+					opts = append(opts, strimzi.WithProtectionPolicies(strimzi.ProtectCompacted))
+				})
+				It("returns ErrTopicProtected", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicProtected)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("cleanup.policy=compact"))
+					Expect(exists()).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Context("config validation", func() {
+		var opts []strimzi.TopicDeployerOption
+		BeforeEach(func() {
+			opts = []strimzi.TopicDeployerOption{strimzi.WithConfigValidation()}
+			topic.Spec.Config = map[string]string{
+				"retention.msec": "86400000",
+				"cleanup.policy": "compacted",
+			}
+		})
+		JustBeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset, opts...)
+			err = topicDeployer.Deploy(ctx, topic)
+		})
+		It("returns ErrTopicConfigInvalid", func() {
+			Expect(stderrors.Is(err, strimzi.ErrTopicConfigInvalid)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("unknown key retention.msec"))
+			Expect(err.Error()).To(ContainSubstring("cleanup.policy has invalid value compacted"))
+		})
+		It("sends nothing to the api server", func() {
+			Expect(clientset.Actions()).To(BeEmpty())
+		})
+		Context("config is valid", func() {
+			BeforeEach(func() {
+				topic.Spec.Config = map[string]string{"retention.ms": "86400000"}
+			})
+			It("deploys the topic", func() {
+				Expect(err).To(BeNil())
+				Expect(countActions("create")).To(Equal(1))
+			})
+		})
+		Context("without validation", func() {
+			BeforeEach(func() {
+				opts = nil
+			})
+			It("deploys the topic", func() {
+				Expect(err).To(BeNil())
+			})
+		})
+	})
+
+	Context("cluster label", func() {
+		var opts []strimzi.TopicDeployerOption
+		get := func() *v1beta2.KafkaTopic {
+			result, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Get(ctx, "my-topic", metav1.GetOptions{})
+			Expect(err).To(BeNil())
+			return result
+		}
+		topicExists := func() bool {
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Get(ctx, "my-topic", metav1.GetOptions{})
+			return err == nil
+		}
+		BeforeEach(func() {
+			opts = nil
+			_, err := clientset.KafkaV1beta2().
+				Kafkas("kafka").
+				Create(ctx, &v1beta2.Kafka{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "kafka"},
+				}, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		})
+		JustBeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset, opts...)
+			err = topicDeployer.Deploy(ctx, topic)
+		})
+		It("deploys topics without cluster label by default", func() {
+			Expect(err).To(BeNil())
+			Expect(get().Labels).NotTo(HaveKey(v1beta2.ClusterLabel))
+		})
+		Context("with default cluster", func() {
+			BeforeEach(func() {
+				opts = append(opts, strimzi.WithDefaultCluster("my-cluster"))
+			})
+			It("sets the cluster label", func() {
+				Expect(err).To(BeNil())
+				Expect(get().Labels).To(HaveKeyWithValue(v1beta2.ClusterLabel, "my-cluster"))
+			})
+			It("does not modify the passed topic", func() {
+				Expect(topic.Labels).To(BeNil())
+			})
+			Context("topic has a cluster label", func() {
+				BeforeEach(func() {
+					topic.Labels = map[string]string{v1beta2.ClusterLabel: "other-cluster"}
+				})
+				It("keeps the label", func() {
+					Expect(err).To(BeNil())
+					Expect(get().Labels).To(HaveKeyWithValue(v1beta2.ClusterLabel, "other-cluster"))
+				})
+			})
+		})
+		Context("with namespace cluster resolver", func() {
+			BeforeEach(func() {
+				opts = append(opts, strimzi.WithClusterResolver(
+					strimzi.NewNamespaceClusterResolver(clientset, strimzi.APIVersionV1beta2),
+				))
+			})
+			It("sets the only cluster", func() {
+				Expect(err).To(BeNil())
+				Expect(get().Labels).To(HaveKeyWithValue(v1beta2.ClusterLabel, "my-cluster"))
+			})
+		})
+		Context("with cluster validation", func() {
+			BeforeEach(func() {
+				opts = append(opts, strimzi.WithClusterValidation())
+			})
+			It("returns ErrTopicClusterMissing", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicClusterMissing)).To(BeTrue())
+				Expect(topicExists()).To(BeFalse())
+			})
+			Context("cluster exists", func() {
+				BeforeEach(func() {
+					topic.Labels = map[string]string{v1beta2.ClusterLabel: "my-cluster"}
+				})
+				It("deploys the topic", func() {
+					Expect(err).To(BeNil())
+					Expect(get().Labels).To(HaveKeyWithValue(v1beta2.ClusterLabel, "my-cluster"))
+				})
+			})
+			Context("cluster does not exist", func() {
+				BeforeEach(func() {
+					topic.Labels = map[string]string{v1beta2.ClusterLabel: "other-cluster"}
+				})
+				It("returns ErrTopicClusterNotFound", func() {
+					Expect(stderrors.Is(err, strimzi.ErrTopicClusterNotFound)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("other-cluster"))
+					Expect(topicExists()).To(BeFalse())
+				})
+			})
+			Context("and default cluster", func() {
+				BeforeEach(func() {
+					opts = append(opts, strimzi.WithDefaultCluster("my-cluster"))
+				})
+				It("deploys the topic", func() {
+					Expect(err).To(BeNil())
+				})
+			})
+			Context("and cluster resolver fails", func() {
+				BeforeEach(func() {
+					opts = append(opts, strimzi.WithClusterResolver(strimzi.ClusterResolverFunc(
+						func(ctx context.Context, topic v1beta2.KafkaTopic) (string, error) {
+							return "", stderrors.New("banana")
+						},
+					)))
+				})
+				It("returns the error", func() {
+					Expect(err).NotTo(BeNil())
+					Expect(err.Error()).To(ContainSubstring("banana"))
+				})
+			})
+		})
+	})
+
+	Context("Undeploy with wait and detach", func() {
+		var undeployOpts []strimzi.UndeployOption
+		// get reads from the tracker, because reactors must not call the clientset
+		get := func() (*v1beta2.KafkaTopic, error) {
+			obj, err := clientset.Tracker().
+				Get(topicResource.WithVersion("v1beta2"), "kafka", "my-topic", metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return obj.(*v1beta2.KafkaTopic), nil
+		}
+		// keepOnDelete simulates the finalizer of the topic operator: the topic is only
+		// marked as deleted.
+		keepOnDelete := func() {
+			clientset.PrependReactor(
+				"delete",
+				"kafkatopics",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					current, err := get()
+					Expect(err).To(BeNil())
+					current.DeletionTimestamp = collection.Ptr(metav1.Now())
+					return true, nil, clientset.Tracker().
+						Update(topicResource.WithVersion("v1beta2"), current, "kafka", metav1.UpdateOptions{})
+				},
+			)
+		}
+		BeforeEach(func() {
+			undeployOpts = []strimzi.UndeployOption{strimzi.WithWaitForDeletion(time.Second)}
+			topic.Finalizers = []string{v1beta2.TopicOperatorFinalizer}
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Create(ctx, &topic, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+			topicDeployer = strimzi.NewTopicDeployer(clientset)
+		})
+		Context("resource is deleted immediately", func() {
+			It("returns no error", func() {
+				Expect(topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)).
+					To(Succeed())
+			})
+		})
+		Context("finalizer is removed later", func() {
+			BeforeEach(func() {
+				keepOnDelete()
+			})
+			It("waits until the resource is deleted", func() {
+				done := make(chan error, 1)
+				go func() {
+					defer GinkgoRecover()
+					done <- topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)
+				}()
+				Eventually(func() int {
+					return countActions("watch")
+				}).Should(BeNumerically(">", 0))
+				Consistently(done, 50*time.Millisecond).ShouldNot(Receive())
+				Expect(clientset.Tracker().Delete(
+					topicResource.WithVersion("v1beta2"),
+					"kafka",
+					"my-topic",
+				)).To(Succeed())
+				Eventually(done).Should(Receive(BeNil()))
+			})
+		})
+		Context("finalizer is stuck", func() {
+			BeforeEach(func() {
+				keepOnDelete()
+				undeployOpts = []strimzi.UndeployOption{
+					strimzi.WithWaitForDeletion(50 * time.Millisecond),
+				}
+			})
+			It("returns ErrTopicDeletionStuck with the finalizers", func() {
+				err := topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)
+				Expect(stderrors.Is(err, strimzi.ErrTopicDeletionStuck)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(v1beta2.TopicOperatorFinalizer))
+			})
+		})
+		Context("detach", func() {
+			var annotations map[string]string
+			BeforeEach(func() {
+				clientset.PrependReactor(
+					"delete",
+					"kafkatopics",
+					func(action k8stesting.Action) (bool, runtime.Object, error) {
+						current, err := get()
+						Expect(err).To(BeNil())
+						annotations = current.Annotations
+						return false, nil, nil
+					},
+				)
+				undeployOpts = []strimzi.UndeployOption{strimzi.WithDetach()}
+			})
+			It("marks the topic as unmanaged before it is deleted", func() {
+				Expect(topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)).
+					To(Succeed())
+				Expect(annotations).To(HaveKeyWithValue(v1beta2.ManagedAnnotation, "false"))
+				_, err := get()
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+			It("ignores the protection", func() {
+				current, err := get()
+				Expect(err).To(BeNil())
+				current.Annotations = map[string]string{strimzi.ProtectAnnotation: "true"}
+				_, err = clientset.KafkaV1beta2().
+					KafkaTopics("kafka").
+					Update(ctx, current, metav1.UpdateOptions{})
+				Expect(err).To(BeNil())
+				Expect(topicDeployer.Undeploy(ctx, "kafka", "my-topic", undeployOpts...)).
+					To(Succeed())
+			})
+		})
+	})
+
+	Context("dry run", func() {
+		var result strimzi.DeployResult
+		dryRuns := func() [][]string {
+			var result [][]string
+			for _, action := range clientset.Actions() {
+				switch a := action.(type) {
+				case k8stesting.CreateActionImpl:
+					result = append(result, a.CreateOptions.DryRun)
+				case k8stesting.UpdateActionImpl:
+					result = append(result, a.UpdateOptions.DryRun)
+				case k8stesting.DeleteActionImpl:
+					result = append(result, a.DeleteOptions.DryRun)
+				}
+			}
+			return result
+		}
+		BeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset, strimzi.WithDryRun())
+		})
+		Context("topic does not exist", func() {
+			JustBeforeEach(func() {
+				result, err = topicDeployer.DeployWithResult(ctx, topic)
+			})
+			It("sends the create as dry run", func() {
+				Expect(err).To(BeNil())
+				Expect(dryRuns()).To(Equal([][]string{{metav1.DryRunAll}}))
+			})
+			It("reports the diff", func() {
+				Expect(result.Diff.Action).To(Equal(strimzi.DeployActionCreated))
+				Expect(*result.Diff.Partitions.New).To(Equal(int32(3)))
+			})
+		})
+		Context("topic exists", func() {
+			BeforeEach(func() {
+				existing := topic.DeepCopy()
+				existing.Spec.Partitions = collection.Ptr(int32(1))
+				_, err := clientset.KafkaV1beta2().
+					KafkaTopics("kafka").
+					Create(ctx, existing, metav1.CreateOptions{})
+				Expect(err).To(BeNil())
+				clientset.ClearActions()
+			})
+			It("sends the update as dry run and reports the diff", func() {
+				result, err = topicDeployer.DeployWithResult(ctx, topic)
+				Expect(err).To(BeNil())
+				Expect(dryRuns()).To(Equal([][]string{{metav1.DryRunAll}}))
+				Expect(result.Diff.Partitions.String()).To(Equal("1 -> 3"))
+			})
+			It("does not wait for the topic", func() {
+				result, err = topicDeployer.DeployAndWait(ctx, topic, time.Second)
+				Expect(err).To(BeNil())
+				Expect(countActions("watch")).To(Equal(0))
+			})
+			It("sends the delete as dry run", func() {
+				Expect(topicDeployer.Undeploy(ctx, "kafka", "my-topic")).To(Succeed())
+				Expect(dryRuns()).To(Equal([][]string{{metav1.DryRunAll}}))
+			})
+		})
+		It("does not send writes as dry run by default", func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset)
+			Expect(topicDeployer.Deploy(ctx, topic)).To(Succeed())
+			Expect(dryRuns()).To(Equal([][]string{nil}))
+		})
+	})
+
+	Context("server-side apply", func() {
+		var opts []strimzi.TopicDeployerOption
+		var result strimzi.DeployResult
+		applyAs := func(fieldManager string, apply *applyv1beta2.KafkaTopicApplyConfiguration) {
+			_, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Apply(ctx, apply, metav1.ApplyOptions{FieldManager: fieldManager})
+			Expect(err).To(BeNil())
+		}
+		get := func() *v1beta2.KafkaTopic {
+			result, err := clientset.KafkaV1beta2().
+				KafkaTopics("kafka").
+				Get(ctx, "my-topic", metav1.GetOptions{})
+			Expect(err).To(BeNil())
+			return result
+		}
+		BeforeEach(func() {
+			clientset = fake.NewApplyClientset()
+			opts = []strimzi.TopicDeployerOption{strimzi.WithServerSideApply("my-manager")}
+		})
+		JustBeforeEach(func() {
+			topicDeployer = strimzi.NewTopicDeployer(clientset, opts...)
+			result, err = topicDeployer.DeployWithResult(ctx, topic)
+		})
+		Context("topic does not exist", func() {
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("reports the topic as applied", func() {
+				Expect(result.Action).To(Equal(strimzi.DeployActionApplied))
+			})
+			It("creates the topic owned by the field manager", func() {
+				result := get()
+				Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				Expect(result.ManagedFields).To(HaveLen(1))
+				Expect(result.ManagedFields[0].Manager).To(Equal("my-manager"))
+			})
+			It("reads the topic and sends an apply patch", func() {
+				Expect(countActions("get")).To(Equal(1))
+				Expect(countActions("update")).To(Equal(0))
+				Expect(countActions("patch")).To(Equal(1))
+			})
+			Context("with allow policies", func() {
+				BeforeEach(func() {
+					opts = append(
+						opts,
+						strimzi.WithPartitionDecreasePolicy(strimzi.SpecChangePolicyAllow),
+						strimzi.WithReplicasChangePolicy(strimzi.SpecChangePolicyAllow),
+					)
+				})
+				It("does not read the topic", func() {
+					Expect(countActions("get")).To(Equal(0))
+					Expect(countActions("patch")).To(Equal(1))
+				})
+			})
+		})
+		Context("partitions are decreased", func() {
+			BeforeEach(func() {
+				applyAs("my-manager", applyv1beta2.KafkaTopic("my-topic", "kafka").
+					WithSpec(applyv1beta2.KafkaTopicSpec().WithPartitions(6)))
+			})
+			It("returns ErrTopicPartitionDecrease", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicPartitionDecrease)).To(BeTrue())
+			})
+			It("does not apply the topic", func() {
+				Expect(*get().Spec.Partitions).To(Equal(int32(6)))
+			})
+			Context("with keep current", func() {
+				BeforeEach(func() {
+					opts = append(
+						opts,
+						strimzi.WithPartitionDecreasePolicy(strimzi.SpecChangePolicyKeepCurrent),
+					)
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("applies the current partitions", func() {
+					Expect(*get().Spec.Partitions).To(Equal(int32(6)))
+				})
+			})
+		})
+		Context("topic has fields of other managers", func() {
+			BeforeEach(func() {
+				applyAs("argocd", applyv1beta2.KafkaTopic("my-topic", "kafka").
+					WithLabels(map[string]string{"argocd.argoproj.io/instance": "kafka"}))
+				applyAs("strimzi", applyv1beta2.KafkaTopic("my-topic", "kafka").
+					WithAnnotations(map[string]string{"strimzi.io/managed": "true"}))
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("keeps labels and annotations of other managers", func() {
+				result := get()
+				Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+				Expect(result.Labels).To(HaveKeyWithValue("argocd.argoproj.io/instance", "kafka"))
+				Expect(result.Annotations).To(HaveKeyWithValue("strimzi.io/managed", "true"))
+			})
+		})
+		Context("another manager owns a field", func() {
+			BeforeEach(func() {
+				applyAs("other", applyv1beta2.KafkaTopic("my-topic", "kafka").
+					WithSpec(applyv1beta2.KafkaTopicSpec().WithPartitions(1)))
+			})
+			It("returns ErrTopicFieldManagerConflict", func() {
+				Expect(stderrors.Is(err, strimzi.ErrTopicFieldManagerConflict)).To(BeTrue())
+				Expect(stderrors.Is(err, strimzi.ErrTopicConflict)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(".spec.partitions"))
+			})
+			It("does not change the field", func() {
+				Expect(*get().Spec.Partitions).To(Equal(int32(1)))
+			})
+			Context("with force conflicts", func() {
+				BeforeEach(func() {
+					opts = append(opts, strimzi.WithForceConflicts())
+				})
+				It("returns no error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("takes ownership of the field", func() {
+					Expect(*get().Spec.Partitions).To(Equal(int32(3)))
+				})
+			})
+		})
+		Context("v1", func() {
+			BeforeEach(func() {
+				opts = append(opts, strimzi.WithAPIVersion(strimzi.APIVersionV1))
+			})
+			It("applies the topic with the v1 api", func() {
+				Expect(err).To(BeNil())
+				result, err := clientset.KafkaV1().
+					KafkaTopics("kafka").
+					Get(ctx, "my-topic", metav1.GetOptions{})
+				Expect(err).To(BeNil())
+				Expect(*result.Spec.Partitions).To(Equal(int32(3)))
+			})
+		})
+	})
+
+	It("returns an error for an unsupported api version", func() {
+		topicDeployer = strimzi.NewTopicDeployer(clientset, strimzi.WithAPIVersion("v2"))
+		err = topicDeployer.Deploy(ctx, topic)
+		Expect(err).NotTo(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unsupported api version 'v2'"))
+	})
+})
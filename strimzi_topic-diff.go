@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// KeyChangeType describes how the value of a map key changed.
+type KeyChangeType = v1beta2.KeyChangeType
+
+// Types of a KeyChange.
+const (
+	KeyChangeTypeAdded   = v1beta2.KeyChangeTypeAdded
+	KeyChangeTypeRemoved = v1beta2.KeyChangeTypeRemoved
+	KeyChangeTypeChanged = v1beta2.KeyChangeTypeChanged
+)
+
+// KeyChange is the change of a single key of the topic config, labels or annotations.
+type KeyChange = v1beta2.KeyChange
+
+// Int32Change is the change of an optional number like the partitions of a topic.
+// Nil means the value is not set and defaulted by the broker.
+type Int32Change = v1beta2.Int32Change
+
+// StringChange is the change of a string like the topic name.
+type StringChange = v1beta2.StringChange
+
+// TopicDiff describes what deploying a topic changes compared to the existing topic.
+type TopicDiff struct {
+	Namespace   string        `json:"namespace"`
+	Name        string        `json:"name"`
+	Action      DeployAction  `json:"action"`
+	TopicName   *StringChange `json:"topicName,omitempty"`
+	Partitions  *Int32Change  `json:"partitions,omitempty"`
+	Replicas    *Int32Change  `json:"replicas,omitempty"`
+	Config      []KeyChange   `json:"config,omitempty"`
+	Labels      []KeyChange   `json:"labels,omitempty"`
+	Annotations []KeyChange   `json:"annotations,omitempty"`
+}
+
+// NewTopicDiff compares the current topic with the desired one. A nil current topic
+// means the topic does not exist and will be created. If the current topic is up to
+// date, the Deploy skips the update and the diff has no changes.
+func NewTopicDiff(currentTopic *v1beta2.KafkaTopic, desiredTopic v1beta2.KafkaTopic) TopicDiff {
+	result := TopicDiff{
+		Namespace: desiredTopic.Namespace,
+		Name:      desiredTopic.Name,
+	}
+	var current v1beta2.KafkaTopic
+	switch {
+	case currentTopic == nil:
+		result.Action = DeployActionCreated
+	case topicUpToDate(*currentTopic, desiredTopic):
+		result.Action = DeployActionUnchanged
+		return result
+	default:
+		result.Action = DeployActionUpdated
+		current = *currentTopic
+	}
+	if currentTopic == nil {
+		// name the created topic like the desired one instead of reporting a rename
+		current.Name = desiredTopic.Name
+	}
+	specDiff := current.Diff(desiredTopic)
+	result.TopicName = specDiff.TopicName
+	result.Partitions = specDiff.Partitions
+	result.Replicas = specDiff.Replicas
+	result.Config = specDiff.Config
+	result.Labels = newKeyChanges(current.Labels, desiredTopic.Labels)
+	result.Annotations = newKeyChanges(current.Annotations, desiredTopic.Annotations)
+	return result
+}
+
+// HasChanges returns true if deploying the topic changes anything.
+func (t TopicDiff) HasChanges() bool {
+	return t.Action == DeployActionCreated ||
+		t.TopicName != nil ||
+		t.Partitions != nil ||
+		t.Replicas != nil ||
+		len(t.Config) > 0 ||
+		len(t.Labels) > 0 ||
+		len(t.Annotations) > 0
+}
+
+// String renders the diff human readable, one change per line.
+func (t TopicDiff) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "topic %s/%s: %s", t.Namespace, t.Name, t.Action)
+	if t.TopicName != nil {
+		fmt.Fprintf(&sb, "\n  topicName: %s", t.TopicName)
+	}
+	if t.Partitions != nil {
+		fmt.Fprintf(&sb, "\n  partitions: %s", t.Partitions)
+	}
+	if t.Replicas != nil {
+		fmt.Fprintf(&sb, "\n  replicas: %s", t.Replicas)
+	}
+	writeKeyChanges(&sb, "config", t.Config)
+	writeKeyChanges(&sb, "labels", t.Labels)
+	writeKeyChanges(&sb, "annotations", t.Annotations)
+	return sb.String()
+}
+
+func writeKeyChanges(sb *strings.Builder, title string, changes []KeyChange) {
+	if len(changes) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "\n  %s:", title)
+	for _, change := range changes {
+		fmt.Fprintf(sb, "\n    %s", change)
+	}
+}
+
+func topicSpec(topic v1beta2.KafkaTopic) v1beta2.KafkaTopicSpec {
+	if topic.Spec == nil {
+		return v1beta2.KafkaTopicSpec{}
+	}
+	return *topic.Spec
+}
+
+// newKeyChanges returns the changes from current to desired sorted by key.
+func newKeyChanges(current map[string]string, desired map[string]string) []KeyChange {
+	var result []KeyChange
+	for key, value := range desired {
+		currentValue, ok := current[key]
+		switch {
+		case !ok:
+			result = append(result, KeyChange{Key: key, Type: KeyChangeTypeAdded, New: value})
+		case currentValue != value:
+			result = append(result, KeyChange{
+				Key:  key,
+				Type: KeyChangeTypeChanged,
+				Old:  currentValue,
+				New:  value,
+			})
+		}
+	}
+	for key, value := range current {
+		if _, ok := desired[key]; !ok {
+			result = append(result, KeyChange{Key: key, Type: KeyChangeTypeRemoved, Old: value})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
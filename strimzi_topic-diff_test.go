@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"encoding/json"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("TopicDiff", func() {
+	var current *v1beta2.KafkaTopic
+	var desired v1beta2.KafkaTopic
+	var diff strimzi.TopicDiff
+
+	BeforeEach(func() {
+		current = &v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-topic",
+				Namespace:   "kafka",
+				Labels:      map[string]string{"app": "my-app", "team": "a"},
+				Annotations: map[string]string{"owner": "team-a"},
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(int32(3)),
+				Replicas:   collection.Ptr(int32(2)),
+				Config: map[string]string{
+					"retention.ms":   "604800000",
+					"cleanup.policy": "delete",
+				},
+			},
+		}
+		desired = *current.DeepCopy()
+	})
+
+	JustBeforeEach(func() {
+		diff = strimzi.NewTopicDiff(current, desired)
+	})
+
+	Context("topic is up to date", func() {
+		It("reports unchanged", func() {
+			Expect(diff.Action).To(Equal(strimzi.DeployActionUnchanged))
+			Expect(diff.HasChanges()).To(BeFalse())
+		})
+		It("renders only the action", func() {
+			Expect(diff.String()).To(Equal("topic kafka/my-topic: Unchanged"))
+		})
+	})
+
+	Context("config differs only in notation", func() {
+		BeforeEach(func() {
+			desired.Spec.Partitions = collection.Ptr(int32(3))
+			desired.Spec.Config = map[string]string{
+				"retention.ms":   "+0604800000",
+				"cleanup.policy": " delete ",
+			}
+		})
+		It("reports unchanged", func() {
+			Expect(diff.Action).To(Equal(strimzi.DeployActionUnchanged))
+		})
+	})
+
+	Context("topic name changed", func() {
+		BeforeEach(func() {
+			desired.Spec.TopicName = collection.Ptr("renamed")
+		})
+		It("reports the topic name", func() {
+			Expect(diff.TopicName.String()).To(Equal("my-topic -> renamed"))
+			Expect(diff.String()).To(ContainSubstring("topicName: my-topic -> renamed"))
+		})
+	})
+
+	Context("topic does not exist", func() {
+		BeforeEach(func() {
+			current = nil
+		})
+		It("reports everything as added", func() {
+			Expect(diff.Action).To(Equal(strimzi.DeployActionCreated))
+			Expect(diff.HasChanges()).To(BeTrue())
+			Expect(diff.Partitions.Old).To(BeNil())
+			Expect(*diff.Partitions.New).To(Equal(int32(3)))
+			Expect(diff.Config).To(HaveLen(2))
+			Expect(diff.Labels).To(HaveLen(2))
+		})
+	})
+
+	Context("topic changed", func() {
+		BeforeEach(func() {
+			desired.Spec.Partitions = collection.Ptr(int32(6))
+			desired.Spec.Replicas = nil
+			desired.Spec.Config = map[string]string{
+				"retention.ms":        "86400000",
+				"min.insync.replicas": "2",
+			}
+			desired.Labels = map[string]string{"app": "my-app"}
+			desired.Annotations = map[string]string{"owner": "team-b"}
+		})
+		It("reports updated", func() {
+			Expect(diff.Action).To(Equal(strimzi.DeployActionUpdated))
+			Expect(diff.HasChanges()).To(BeTrue())
+		})
+		It("reports the partitions", func() {
+			Expect(diff.Partitions).To(Equal(&strimzi.Int32Change{
+				Old: collection.Ptr(int32(3)),
+				New: collection.Ptr(int32(6)),
+			}))
+		})
+		It("reports the replicas", func() {
+			Expect(diff.Replicas.String()).To(Equal("2 -> default"))
+		})
+		It("reports the config changes sorted by key", func() {
+			Expect(diff.Config).To(Equal([]strimzi.KeyChange{
+				{Key: "cleanup.policy", Type: strimzi.KeyChangeTypeRemoved, Old: "delete"},
+				{Key: "min.insync.replicas", Type: strimzi.KeyChangeTypeAdded, New: "2"},
+				{
+					Key:  "retention.ms",
+					Type: strimzi.KeyChangeTypeChanged,
+					Old:  "604800000",
+					New:  "86400000",
+				},
+			}))
+		})
+		It("reports label and annotation changes", func() {
+			Expect(diff.Labels).To(Equal([]strimzi.KeyChange{
+				{Key: "team", Type: strimzi.KeyChangeTypeRemoved, Old: "a"},
+			}))
+			Expect(diff.Annotations).To(Equal([]strimzi.KeyChange{
+				{Key: "owner", Type: strimzi.KeyChangeTypeChanged, Old: "team-a", New: "team-b"},
+			}))
+		})
+		It("renders a human readable diff", func() {
+			Expect(diff.String()).To(Equal(`topic kafka/my-topic: Updated
+  partitions: 3 -> 6
+  replicas: 2 -> default
+  config:
+    - cleanup.policy: delete
+    + min.insync.replicas: 2
+    ~ retention.ms: 604800000 -> 86400000
+  labels:
+    - team: a
+  annotations:
+    ~ owner: team-a -> team-b`))
+		})
+		It("renders json", func() {
+			content, err := json.Marshal(diff)
+			Expect(err).To(BeNil())
+			Expect(content).To(MatchJSON(`{
+				"namespace": "kafka",
+				"name": "my-topic",
+				"action": "Updated",
+				"partitions": {"old": 3, "new": 6},
+				"replicas": {"old": 2},
+				"config": [
+					{"key": "cleanup.policy", "type": "removed", "old": "delete"},
+					{"key": "min.insync.replicas", "type": "added", "new": "2"},
+					{"key": "retention.ms", "type": "changed", "old": "604800000", "new": "86400000"}
+				],
+				"labels": [{"key": "team", "type": "removed", "old": "a"}],
+				"annotations": [{"key": "owner", "type": "changed", "old": "team-a", "new": "team-b"}]
+			}`))
+		})
+	})
+})
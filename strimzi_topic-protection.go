@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// ProtectAnnotation protects a KafkaTopic from TopicDeployer.Undeploy unless WithForce
+// is set. Every value except "false" enables the protection.
+const ProtectAnnotation = "strimzi.bborbe.de/protect"
+
+// TopicProtectionPolicy decides whether a topic must not be undeployed without
+// WithForce. It returns the reason and true if the topic is protected.
+type TopicProtectionPolicy func(topic v1beta2.KafkaTopic) (string, bool)
+
+// ProtectAnnotated protects topics with the ProtectAnnotation. It is always applied.
+func ProtectAnnotated(topic v1beta2.KafkaTopic) (string, bool) {
+	value, ok := topic.Annotations[ProtectAnnotation]
+	if !ok || value == "false" {
+		return "", false
+	}
+	return fmt.Sprintf("annotation %s=%s", ProtectAnnotation, value), true
+}
+
+// ProtectCompacted protects topics whose cleanup.policy includes compact, e.g. state
+// and changelog topics.
+func ProtectCompacted(topic v1beta2.KafkaTopic) (string, bool) {
+	value := topicSpec(topic).Config["cleanup.policy"]
+	for _, policy := range strings.Split(value, ",") {
+		if strings.TrimSpace(policy) == "compact" {
+			return fmt.Sprintf("cleanup.policy=%s", value), true
+		}
+	}
+	return "", false
+}
+
+// ProtectInfiniteRetention protects topics that retain data forever.
+func ProtectInfiniteRetention() TopicProtectionPolicy {
+	return ProtectConfig("retention.ms", "-1")
+}
+
+// ProtectConfig protects topics with the given config value. Values are compared the
+// way Kafka parses them, see v1beta2.NormalizeConfigValue.
+func ProtectConfig(key string, value string) TopicProtectionPolicy {
+	normalizedValue := v1beta2.NormalizeConfigValue(key, value)
+	return func(topic v1beta2.KafkaTopic) (string, bool) {
+		currentValue, ok := topicSpec(topic).Config[key]
+		if ok && v1beta2.NormalizeConfigValue(key, currentValue) == normalizedValue {
+			return fmt.Sprintf("%s=%s", key, value), true
+		}
+		return "", false
+	}
+}
+
+// topicProtected returns the reason of the first policy protecting the topic.
+func topicProtected(
+	topic v1beta2.KafkaTopic,
+	policies []TopicProtectionPolicy,
+) (string, bool) {
+	if reason, ok := ProtectAnnotated(topic); ok {
+		return reason, true
+	}
+	for _, policy := range policies {
+		if reason, ok := policy(topic); ok {
+			return reason, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+var _ = Describe("TopicProtectionPolicy", func() {
+	newTopic := func(annotations map[string]string, config map[string]string) v1beta2.KafkaTopic {
+		return v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Annotations: annotations},
+			Spec:       &v1beta2.KafkaTopicSpec{Config: config},
+		}
+	}
+	DescribeTable("protects",
+		func(policy strimzi.TopicProtectionPolicy, topic v1beta2.KafkaTopic, expected bool) {
+			reason, ok := policy(topic)
+			Expect(ok).To(Equal(expected))
+			if expected {
+				Expect(reason).NotTo(BeEmpty())
+			}
+		},
+		Entry("annotated", strimzi.TopicProtectionPolicy(strimzi.ProtectAnnotated),
+			newTopic(map[string]string{strimzi.ProtectAnnotation: "true"}, nil), true),
+		Entry("annotated with any value", strimzi.TopicProtectionPolicy(strimzi.ProtectAnnotated),
+			newTopic(map[string]string{strimzi.ProtectAnnotation: ""}, nil), true),
+		Entry("annotated with false", strimzi.TopicProtectionPolicy(strimzi.ProtectAnnotated),
+			newTopic(map[string]string{strimzi.ProtectAnnotation: "false"}, nil), false),
+		Entry("not annotated", strimzi.TopicProtectionPolicy(strimzi.ProtectAnnotated),
+			newTopic(nil, nil), false),
+		Entry("compacted", strimzi.TopicProtectionPolicy(strimzi.ProtectCompacted),
+			newTopic(nil, map[string]string{"cleanup.policy": "compact"}), true),
+		Entry("compacted and deleted", strimzi.TopicProtectionPolicy(strimzi.ProtectCompacted),
+			newTopic(nil, map[string]string{"cleanup.policy": "delete, compact"}), true),
+		Entry("deleted", strimzi.TopicProtectionPolicy(strimzi.ProtectCompacted),
+			newTopic(nil, map[string]string{"cleanup.policy": "delete"}), false),
+		Entry("compacted without spec", strimzi.TopicProtectionPolicy(strimzi.ProtectCompacted),
+			v1beta2.KafkaTopic{}, false),
+		Entry("infinite retention", strimzi.ProtectInfiniteRetention(),
+			newTopic(nil, map[string]string{"retention.ms": "-1"}), true),
+		Entry("infinite retention with spaces", strimzi.ProtectInfiniteRetention(),
+			newTopic(nil, map[string]string{"retention.ms": " -1 "}), true),
+		Entry("config with other notation", strimzi.ProtectConfig("retention.ms", "+86400000"),
+			newTopic(nil, map[string]string{"retention.ms": "86400000"}), true),
+		Entry("finite retention", strimzi.ProtectInfiniteRetention(),
+			newTopic(nil, map[string]string{"retention.ms": "86400000"}), false),
+	)
+})
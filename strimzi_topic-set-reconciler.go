@@ -0,0 +1,276 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+	"sync"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+//counterfeiter:generate -o mocks/topic-set-reconciler.go --fake-name TopicSetReconciler . TopicSetReconciler
+
+// TopicSetReconciler deploys a declared set of Kafka topics in one step.
+type TopicSetReconciler interface {
+	// Reconcile deploys all desired topics to the namespace and, if pruning is enabled,
+	// deletes topics matching the selector that are no longer declared.
+	// The selector identifies the topics owned by the caller, so every desired topic
+	// must carry labels matching it. Topics without namespace are deployed to the given
+	// namespace.
+	Reconcile(
+		ctx context.Context,
+		namespace string,
+		selector labels.Selector,
+		topics v1beta2.KafkaTopics,
+	) (TopicSetResult, error)
+}
+
+// TopicSetResult describes the outcome of TopicSetReconciler.Reconcile.
+type TopicSetResult struct {
+	// Deployed maps the name of every deployed topic to what was done with it.
+	Deployed map[string]DeployAction
+	// Pruned lists the names of the deleted topics.
+	Pruned []string
+}
+
+// Names returns the sorted names of the topics deployed with the given action.
+func (t TopicSetResult) Names(action DeployAction) []string {
+	var result []string
+	for name, deployAction := range t.Deployed {
+		if deployAction == action {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// TopicSetReconcilerOptions holds the configuration of a TopicSetReconciler.
+type TopicSetReconcilerOptions struct {
+	// APIVersion is the kafka.strimzi.io API version used to list KafkaTopics.
+	// It should match the API version of the TopicDeployer. Defaults to APIVersionV1beta2.
+	APIVersion APIVersion
+
+	// Concurrency is the maximum number of topics deployed or deleted in parallel.
+	// Defaults to DefaultTopicSetConcurrency.
+	Concurrency int
+
+	// Prune deletes topics matching the selector that are not declared.
+	Prune bool
+}
+
+// DefaultTopicSetConcurrency is the number of topics reconciled in parallel if none is set.
+const DefaultTopicSetConcurrency = 5
+
+// TopicSetReconcilerOption configures a TopicSetReconciler.
+type TopicSetReconcilerOption func(*TopicSetReconcilerOptions)
+
+// WithTopicSetAPIVersion selects the kafka.strimzi.io API version used to list topics.
+func WithTopicSetAPIVersion(apiVersion APIVersion) TopicSetReconcilerOption {
+	return func(o *TopicSetReconcilerOptions) {
+		o.APIVersion = apiVersion
+	}
+}
+
+// WithConcurrency limits how many topics are deployed or deleted in parallel.
+func WithConcurrency(concurrency int) TopicSetReconcilerOption {
+	return func(o *TopicSetReconcilerOptions) {
+		o.Concurrency = concurrency
+	}
+}
+
+// WithPrune makes the TopicSetReconciler delete topics matching the selector that are
+// no longer declared.
+func WithPrune() TopicSetReconcilerOption {
+	return func(o *TopicSetReconcilerOptions) {
+		o.Prune = true
+	}
+}
+
+// NewTopicSetReconciler creates a new TopicSetReconciler instance.
+//
+// Parameters:
+//   - clientset: Strimzi clientset used to list the existing KafkaTopics
+//   - topicDeployer: Deployer used to deploy and undeploy the single topics
+//   - opts: Optional settings, e.g. WithPrune or WithConcurrency
+//
+// Returns:
+//   - TopicSetReconciler: A new reconciler for sets of Kafka topics
+func NewTopicSetReconciler(
+	clientset versioned.Interface,
+	topicDeployer TopicDeployer,
+	opts ...TopicSetReconcilerOption,
+) TopicSetReconciler {
+	options := TopicSetReconcilerOptions{
+		APIVersion:  APIVersionV1beta2,
+		Concurrency: DefaultTopicSetConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Concurrency < 1 {
+		options.Concurrency = 1
+	}
+	return &topicSetReconciler{
+		topicClient:   newTopicClient(clientset, options.APIVersion),
+		topicDeployer: topicDeployer,
+		options:       options,
+	}
+}
+
+type topicSetReconciler struct {
+	topicClient   topicClient
+	topicDeployer TopicDeployer
+	options       TopicSetReconcilerOptions
+}
+
+func (t *topicSetReconciler) Reconcile(
+	ctx context.Context,
+	namespace string,
+	selector labels.Selector,
+	topics v1beta2.KafkaTopics,
+) (TopicSetResult, error) {
+	result := TopicSetResult{
+		Deployed: map[string]DeployAction{},
+	}
+	if t.options.Prune && selector.Empty() {
+		return result, errors.Errorf(ctx, "prune topics without selector not allowed")
+	}
+	desired, err := t.desiredTopics(ctx, namespace, selector, topics)
+	if err != nil {
+		return result, err
+	}
+	current, err := t.topicClient.List(ctx, namespace, selector.String())
+	if err != nil {
+		return result, errors.Wrapf(
+			ctx,
+			topicError(err),
+			"list topics in namespace %s failed",
+			namespace,
+		)
+	}
+	var prune []string
+	if t.options.Prune {
+		for _, topic := range current {
+			if _, ok := desired[topic.Name]; !ok {
+				prune = append(prune, topic.Name)
+			}
+		}
+		sort.Strings(prune)
+	}
+	glog.V(2).Infof(
+		"reconcile %d topics in namespace %s: %d exist, %d to prune",
+		len(topics),
+		namespace,
+		len(current),
+		len(prune),
+	)
+
+	var mux sync.Mutex
+	errs := t.parallelize(ctx, len(topics), func(ctx context.Context, i int) error {
+		topic := desired[topics[i].Name]
+		deployResult, err := t.topicDeployer.DeployWithResult(ctx, topic)
+		if err != nil {
+			return errors.Wrapf(ctx, err, "deploy topic %s failed", topic.Name)
+		}
+		mux.Lock()
+		defer mux.Unlock()
+		result.Deployed[topic.Name] = deployResult.Action
+		return nil
+	})
+	if len(errs) > 0 {
+		return result, stderrors.Join(errs...)
+	}
+
+	errs = t.parallelize(ctx, len(prune), func(ctx context.Context, i int) error {
+		if err := t.topicDeployer.Undeploy(ctx, namespace, prune[i]); err != nil {
+			return errors.Wrapf(ctx, err, "prune topic %s failed", prune[i])
+		}
+		mux.Lock()
+		defer mux.Unlock()
+		result.Pruned = append(result.Pruned, prune[i])
+		return nil
+	})
+	sort.Strings(result.Pruned)
+	if len(errs) > 0 {
+		return result, stderrors.Join(errs...)
+	}
+	glog.V(2).Infof(
+		"reconcile topics in namespace %s completed: %d deployed, %d pruned",
+		namespace,
+		len(result.Deployed),
+		len(result.Pruned),
+	)
+	return result, nil
+}
+
+// desiredTopics validates the declared topics and returns them by name with the
+// namespace set.
+func (t *topicSetReconciler) desiredTopics(
+	ctx context.Context,
+	namespace string,
+	selector labels.Selector,
+	topics v1beta2.KafkaTopics,
+) (map[string]v1beta2.KafkaTopic, error) {
+	result := make(map[string]v1beta2.KafkaTopic, len(topics))
+	for _, topic := range topics {
+		if topic.Namespace == "" {
+			topic.Namespace = namespace
+		}
+		if topic.Namespace != namespace {
+			return nil, errors.Errorf(
+				ctx,
+				"topic %s has namespace %s instead of %s",
+				topic.Name,
+				topic.Namespace,
+				namespace,
+			)
+		}
+		if !selector.Matches(labels.Set(topic.Labels)) {
+			return nil, errors.Errorf(
+				ctx,
+				"topic %s does not match selector '%s'",
+				topic.Name,
+				selector,
+			)
+		}
+		if _, ok := result[topic.Name]; ok {
+			return nil, errors.Errorf(ctx, "topic %s declared twice", topic.Name)
+		}
+		result[topic.Name] = topic
+	}
+	return result, nil
+}
+
+// parallelize calls fn for 0 <= i < pieces with the configured concurrency and
+// returns all errors.
+func (t *topicSetReconciler) parallelize(
+	ctx context.Context,
+	pieces int,
+	fn func(ctx context.Context, i int) error,
+) []error {
+	var mux sync.Mutex
+	var errs []error
+	workqueue.ParallelizeUntil(ctx, t.options.Concurrency, pieces, func(i int) {
+		if err := fn(ctx, i); err != nil {
+			mux.Lock()
+			defer mux.Unlock()
+			errs = append(errs, err)
+		}
+	})
+	if len(errs) == 0 && ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
+	return errs
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/bborbe/strimzi"
+	kafkav1 "github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("TopicSetReconciler", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var opts []strimzi.TopicSetReconcilerOption
+	var selector labels.Selector
+	var topics v1beta2.KafkaTopics
+	var result strimzi.TopicSetResult
+	var err error
+
+	newTopic := func(name string, partitions int32, topicLabels map[string]string) v1beta2.KafkaTopic {
+		return v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "kafka",
+				Labels:    topicLabels,
+			},
+			Spec: &v1beta2.KafkaTopicSpec{
+				Partitions: collection.Ptr(partitions),
+			},
+		}
+	}
+	owned := map[string]string{"app": "my-app"}
+	create := func(topic v1beta2.KafkaTopic) {
+		_, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			Create(ctx, &topic, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+	}
+	names := func() []string {
+		list, err := clientset.KafkaV1beta2().
+			KafkaTopics("kafka").
+			List(ctx, metav1.ListOptions{})
+		Expect(err).To(BeNil())
+		var result []string
+		for _, topic := range list.Items {
+			result = append(result, topic.Name)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		opts = nil
+		selector = labels.SelectorFromSet(owned)
+		topics = v1beta2.KafkaTopics{
+			newTopic("created", 3, owned),
+			newTopic("updated", 6, owned),
+			newTopic("unchanged", 3, owned),
+		}
+		create(newTopic("updated", 3, owned))
+		create(newTopic("unchanged", 3, owned))
+		create(newTopic("undeclared", 3, owned))
+		create(newTopic("foreign", 3, map[string]string{"app": "other-app"}))
+	})
+
+	JustBeforeEach(func() {
+		topicSetReconciler := strimzi.NewTopicSetReconciler(
+			clientset,
+			strimzi.NewTopicDeployer(clientset),
+			opts...,
+		)
+		result, err = topicSetReconciler.Reconcile(ctx, "kafka", selector, topics)
+	})
+
+	It("returns no error", func() {
+		Expect(err).To(BeNil())
+	})
+	It("reports what was done with each topic", func() {
+		Expect(result.Names(strimzi.DeployActionCreated)).To(Equal([]string{"created"}))
+		Expect(result.Names(strimzi.DeployActionUpdated)).To(Equal([]string{"updated"}))
+		Expect(result.Names(strimzi.DeployActionUnchanged)).To(Equal([]string{"unchanged"}))
+	})
+	It("keeps undeclared topics", func() {
+		Expect(result.Pruned).To(BeEmpty())
+		Expect(names()).To(ContainElement("undeclared"))
+	})
+
+	Context("with prune", func() {
+		BeforeEach(func() {
+			opts = append(opts, strimzi.WithPrune(), strimzi.WithConcurrency(1))
+		})
+		It("deletes undeclared topics matching the selector", func() {
+			Expect(err).To(BeNil())
+			Expect(result.Pruned).To(Equal([]string{"undeclared"}))
+			Expect(names()).To(ConsistOf("created", "updated", "unchanged", "foreign"))
+		})
+		Context("without selector", func() {
+			BeforeEach(func() {
+				selector = labels.Everything()
+			})
+			It("returns an error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+			It("deletes nothing", func() {
+				Expect(names()).To(HaveLen(4))
+			})
+		})
+		Context("a deploy fails", func() {
+			BeforeEach(func() {
+				clientset.PrependReactor(
+					"create",
+					"kafkatopics",
+					func(action k8stesting.Action) (bool, runtime.Object, error) {
+						return true, nil, stderrors.New("banana")
+					},
+				)
+			})
+			It("returns the error", func() {
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring("deploy topic created failed"))
+			})
+			It("deploys the other topics", func() {
+				Expect(result.Names(strimzi.DeployActionUpdated)).To(Equal([]string{"updated"}))
+			})
+			It("does not prune", func() {
+				Expect(names()).To(ContainElement("undeclared"))
+			})
+		})
+	})
+
+	Context("topic does not match the selector", func() {
+		BeforeEach(func() {
+			topics = append(topics, newTopic("unlabeled", 3, nil))
+		})
+		It("returns an error", func() {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("unlabeled"))
+		})
+		It("deploys nothing", func() {
+			Expect(names()).NotTo(ContainElement("created"))
+		})
+	})
+
+	Context("topic declared twice", func() {
+		BeforeEach(func() {
+			topics = append(topics, newTopic("created", 6, owned))
+		})
+		It("returns an error", func() {
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("topic without namespace", func() {
+		BeforeEach(func() {
+			topics[0].Namespace = ""
+		})
+		It("deploys the topic to the namespace", func() {
+			Expect(err).To(BeNil())
+			Expect(names()).To(ContainElement("created"))
+		})
+	})
+
+	Context("topic in another namespace", func() {
+		BeforeEach(func() {
+			topics[0].Namespace = "other"
+		})
+		It("returns an error", func() {
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("v1", func() {
+		var v1Clientset *fake.Clientset
+		BeforeEach(func() {
+			v1Clientset = fake.NewSimpleClientset()
+			undeclared := kafkav1.KafkaTopicFromV1beta2(newTopic("undeclared", 3, owned))
+			_, err := v1Clientset.KafkaV1().
+				KafkaTopics("kafka").
+				Create(ctx, &undeclared, metav1.CreateOptions{})
+			Expect(err).To(BeNil())
+		})
+		It("lists and prunes topics with the v1 api", func() {
+			topicSetReconciler := strimzi.NewTopicSetReconciler(
+				v1Clientset,
+				strimzi.NewTopicDeployer(
+					v1Clientset,
+					strimzi.WithAPIVersion(strimzi.APIVersionV1),
+				),
+				strimzi.WithTopicSetAPIVersion(strimzi.APIVersionV1),
+				strimzi.WithPrune(),
+			)
+			result, err := topicSetReconciler.Reconcile(ctx, "kafka", selector, topics[:1])
+			Expect(err).To(BeNil())
+			Expect(result.Pruned).To(Equal([]string{"undeclared"}))
+			Expect(result.Names(strimzi.DeployActionCreated)).To(Equal([]string{"created"}))
+		})
+	})
+})
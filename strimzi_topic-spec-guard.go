@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/errors"
+	"github.com/golang/glog"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+)
+
+// SpecChangePolicy defines how TopicDeployer handles spec changes of an existing
+// topic that the topic operator refuses to reconcile.
+type SpecChangePolicy string
+
+const (
+	// SpecChangePolicyReject fails the deploy with a descriptive error.
+	SpecChangePolicyReject SpecChangePolicy = "Reject"
+	// SpecChangePolicyKeepCurrent deploys the topic with the current value and logs a warning.
+	SpecChangePolicyKeepCurrent SpecChangePolicy = "KeepCurrent"
+	// SpecChangePolicyAllow submits the change as is, e.g. replica changes on clusters
+	// running Cruise Control.
+	SpecChangePolicyAllow SpecChangePolicy = "Allow"
+)
+
+// String returns the policy as string.
+func (s SpecChangePolicy) String() string {
+	return string(s)
+}
+
+// guardSpecChanges checks the desired topic against the current one before it is
+// submitted. Depending on the policies it returns an error or the desired topic with
+// the current partitions or replicas. Unset values are defaulted by the broker and
+// therefore not compared.
+func guardSpecChanges(
+	ctx context.Context,
+	currentTopic v1beta2.KafkaTopic,
+	desiredTopic v1beta2.KafkaTopic,
+	partitionDecreasePolicy SpecChangePolicy,
+	replicasChangePolicy SpecChangePolicy,
+) (v1beta2.KafkaTopic, error) {
+	if currentTopic.Spec == nil || desiredTopic.Spec == nil {
+		return desiredTopic, nil
+	}
+	current := currentTopic.Spec
+	result := *desiredTopic.DeepCopy()
+	desired := result.Spec
+	if current.Partitions != nil && desired.Partitions != nil &&
+		*desired.Partitions < *current.Partitions {
+		switch partitionDecreasePolicy {
+		case SpecChangePolicyAllow:
+		case SpecChangePolicyKeepCurrent:
+			glog.Warningf(
+				"topic %s: decrease partitions from %d to %d not supported => keep %d",
+				desiredTopic.Name,
+				*current.Partitions,
+				*desired.Partitions,
+				*current.Partitions,
+			)
+			desired.Partitions = current.Partitions
+		default:
+			return desiredTopic, errors.Wrapf(
+				ctx,
+				ErrTopicPartitionDecrease,
+				"decrease partitions of topic %s from %d to %d rejected",
+				desiredTopic.Name,
+				*current.Partitions,
+				*desired.Partitions,
+			)
+		}
+	}
+	if current.Replicas != nil && desired.Replicas != nil &&
+		*desired.Replicas != *current.Replicas {
+		switch replicasChangePolicy {
+		case SpecChangePolicyAllow:
+		case SpecChangePolicyKeepCurrent:
+			glog.Warningf(
+				"topic %s: change replicas from %d to %d not supported => keep %d",
+				desiredTopic.Name,
+				*current.Replicas,
+				*desired.Replicas,
+				*current.Replicas,
+			)
+			desired.Replicas = current.Replicas
+		default:
+			return desiredTopic, errors.Wrapf(
+				ctx,
+				ErrTopicReplicasChange,
+				"change replicas of topic %s from %d to %d rejected",
+				desiredTopic.Name,
+				*current.Replicas,
+				*desired.Replicas,
+			)
+		}
+	}
+	return result, nil
+}
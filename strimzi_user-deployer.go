@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned"
+)
+
+//counterfeiter:generate -o mocks/user-deployer.go --fake-name UserDeployer . UserDeployer
+
+// UserDeployer provides operations for deploying and managing Kafka users in Kubernetes.
+// It handles both creation and updates of KafkaUser custom resources, as well as their removal.
+type UserDeployer interface {
+	// Deploy creates or updates a KafkaUser resource in Kubernetes.
+	// If the user doesn't exist, it will be created. If it exists, it will be updated
+	// with the new configuration while preserving the resource version.
+	// Errors other than NotFound are returned, marked with ErrUserForbidden or
+	// ErrUserConflict where applicable.
+	Deploy(ctx context.Context, user v1beta2.KafkaUser) error
+
+	// Undeploy removes a KafkaUser resource from Kubernetes.
+	// If the user doesn't exist, the operation succeeds silently. Other errors are
+	// returned, marked with ErrUserForbidden where applicable.
+	Undeploy(ctx context.Context, namespace string, name string) error
+}
+
+// NewUserDeployer creates a new UserDeployer instance.
+//
+// Parameters:
+//   - clientset: Strimzi clientset for interacting with KafkaUser resources
+//
+// Returns:
+//   - UserDeployer: A new deployer instance for managing Kafka users
+func NewUserDeployer(
+	clientset versioned.Interface,
+) UserDeployer {
+	return &userDeployer{
+		clientset: clientset,
+	}
+}
+
+type userDeployer struct {
+	clientset versioned.Interface
+}
+
+var userKind = resourceKind{name: "user", markError: userError}
+
+func (u *userDeployer) Deploy(ctx context.Context, user v1beta2.KafkaUser) error {
+	return deployResource(
+		ctx,
+		u.clientset.KafkaV1beta2().KafkaUsers(user.Namespace),
+		userKind,
+		&user,
+	)
+}
+
+func (u *userDeployer) Undeploy(ctx context.Context, namespace string, name string) error {
+	return undeployResource(ctx, u.clientset.KafkaV1beta2().KafkaUsers(namespace), userKind, name)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi_test
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/bborbe/collection"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/bborbe/strimzi"
+	"github.com/bborbe/strimzi/k8s/apis/kafka.strimzi.io/v1beta2"
+	"github.com/bborbe/strimzi/k8s/client/clientset/versioned/fake"
+)
+
+var _ = Describe("UserDeployer", func() {
+	var ctx context.Context
+	var clientset *fake.Clientset
+	var userDeployer strimzi.UserDeployer
+	var user v1beta2.KafkaUser
+
+	userResource := v1beta2.Resource("kafkausers")
+	reactWith := func(verb string, reactErr error) {
+		clientset.PrependReactor(
+			verb,
+			"kafkausers",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, reactErr
+			},
+		)
+	}
+	createUser := func(user *v1beta2.KafkaUser) {
+		_, err := clientset.KafkaV1beta2().
+			KafkaUsers("kafka").
+			Create(ctx, user, metav1.CreateOptions{})
+		Expect(err).To(BeNil())
+	}
+	getUser := func() *v1beta2.KafkaUser {
+		result, err := clientset.KafkaV1beta2().
+			KafkaUsers("kafka").
+			Get(ctx, "my-user", metav1.GetOptions{})
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewSimpleClientset()
+		userDeployer = strimzi.NewUserDeployer(clientset)
+		user = v1beta2.KafkaUser{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-user",
+				Namespace: "kafka",
+			},
+			Spec: &v1beta2.KafkaUserSpec{
+				Authentication: &v1beta2.KafkaUserAuthentication{
+					Type: collection.Ptr(v1beta2.KafkaUserAuthenticationTLS),
+				},
+				Authorization: &v1beta2.KafkaUserAuthorization{
+					Type: collection.Ptr("simple"),
+					Acls: []v1beta2.AclRule{
+						{
+							Resource: &v1beta2.AclRuleResource{
+								Type: collection.Ptr(v1beta2.AclResourceTypeTopic),
+								Name: collection.Ptr("my-topic"),
+							},
+							Operations: []string{v1beta2.AclOperationRead},
+						},
+					},
+				},
+				Quotas: &v1beta2.KafkaUserQuotas{
+					ProducerByteRate: collection.Ptr(int32(1048576)),
+				},
+			},
+		}
+	})
+
+	It("creates the user with its acls and quotas", func() {
+		Expect(userDeployer.Deploy(ctx, user)).To(Succeed())
+		result := getUser()
+		Expect(*result.Spec.Authentication.Type).To(Equal(v1beta2.KafkaUserAuthenticationTLS))
+		Expect(result.Spec.Authorization.Acls).To(HaveLen(1))
+		Expect(*result.Spec.Quotas.ProducerByteRate).To(Equal(int32(1048576)))
+	})
+
+	It("replaces the acls and quotas of an existing user", func() {
+		existing := user.DeepCopy()
+		existing.Spec.Authorization.Acls = append(existing.Spec.Authorization.Acls, v1beta2.AclRule{
+			Resource: &v1beta2.AclRuleResource{
+				Type: collection.Ptr(v1beta2.AclResourceTypeCluster),
+			},
+			Operations: []string{v1beta2.AclOperationAll},
+		})
+		existing.Spec.Quotas.ConsumerByteRate = collection.Ptr(int32(2048))
+		createUser(existing)
+
+		Expect(userDeployer.Deploy(ctx, user)).To(Succeed())
+		result := getUser()
+		Expect(result.Spec.Authorization.Acls).To(Equal(user.Spec.Authorization.Acls))
+		Expect(result.Spec.Quotas.ConsumerByteRate).To(BeNil())
+	})
+
+	It("returns ErrUserForbidden without creating the user if get is forbidden", func() {
+		reactWith("get", apierrors.NewForbidden(userResource, "my-user", stderrors.New("rbac")))
+		err := userDeployer.Deploy(ctx, user)
+		Expect(stderrors.Is(err, strimzi.ErrUserForbidden)).To(BeTrue())
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		for _, action := range clientset.Actions() {
+			Expect(action.GetVerb()).NotTo(Equal("create"))
+		}
+	})
+
+	It("returns ErrUserConflict if the update conflicts", func() {
+		createUser(user.DeepCopy())
+		reactWith(
+			"update",
+			apierrors.NewConflict(userResource, "my-user", stderrors.New("modified")),
+		)
+		Expect(stderrors.Is(userDeployer.Deploy(ctx, user), strimzi.ErrUserConflict)).To(BeTrue())
+	})
+
+	It("deletes the user", func() {
+		createUser(user.DeepCopy())
+		Expect(userDeployer.Undeploy(ctx, "kafka", "my-user")).To(Succeed())
+		_, err := clientset.KafkaV1beta2().
+			KafkaUsers("kafka").
+			Get(ctx, "my-user", metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("skips the undeploy of a missing user", func() {
+		Expect(userDeployer.Undeploy(ctx, "kafka", "my-user")).To(Succeed())
+	})
+
+	It("returns ErrUserForbidden if the delete is forbidden", func() {
+		createUser(user.DeepCopy())
+		reactWith("delete", apierrors.NewForbidden(userResource, "my-user", stderrors.New("rbac")))
+		err := userDeployer.Undeploy(ctx, "kafka", "my-user")
+		Expect(stderrors.Is(err, strimzi.ErrUserForbidden)).To(BeTrue())
+	})
+})
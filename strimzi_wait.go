@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package strimzi
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// newNameListWatch returns a ListerWatcher restricted to the object with the given name.
+// The client is used to detect whether WatchList semantics are supported, like the
+// generated informers do.
+func newNameListWatch(
+	client any,
+	name string,
+	list func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error),
+	watchFn func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error),
+) cache.ListerWatcher {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	return cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			opts.FieldSelector = fieldSelector
+			return list(ctx, opts)
+		},
+		WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = fieldSelector
+			return watchFn(ctx, opts)
+		},
+	}, client)
+}
+
+// waitUntil watches the objects of the given ListerWatcher until the condition
+// is met, the condition returns an error or the timeout expires.
+func waitUntil(
+	ctx context.Context,
+	timeout time.Duration,
+	lw cache.ListerWatcher,
+	objType runtime.Object,
+	precondition watchtools.PreconditionFunc,
+	condition watchtools.ConditionFunc,
+) (*watch.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	event, err := watchtools.UntilWithSync(ctx, lw, objType, precondition, condition)
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "wait failed after %v", timeout)
+	}
+	return event, nil
+}